@@ -2,6 +2,8 @@ package card
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // MARK: Types
@@ -12,6 +14,7 @@ const (
 	Hearts
 	Diamonds
 	Clubs
+	JokerSuit
 )
 
 type Card struct {
@@ -19,9 +22,121 @@ type Card struct {
 	Value int
 }
 
+// MARK: Functions
+
+/**
+ * Creates a new joker card, for use by wildcard variants (e.g. Five Crowns)
+ * where it substitutes for whichever card yields the best hand.
+ */
+func NewJoker() Card {
+	return Card{Suit: JokerSuit, Value: 0}
+}
+
+/**
+ * Parses a single card from its compact string form, e.g. "As", "Th", or
+ * "9♠". Accepts both ASCII suit letters (s/h/d/c) and the Unicode suit
+ * glyphs used by String().
+ */
+func NewCardFromString(s string) (Card, error) {
+	runes := []rune(s)
+	if len(runes) < 2 {
+		return Card{}, fmt.Errorf("invalid card string %q", s)
+	}
+
+	suit, err := suitFromRune(runes[len(runes)-1])
+	if err != nil {
+		return Card{}, fmt.Errorf("invalid card string %q: %v", s, err)
+	}
+
+	value, err := valueFromString(string(runes[:len(runes)-1]))
+	if err != nil {
+		return Card{}, fmt.Errorf("invalid card string %q: %v", s, err)
+	}
+
+	return Card{Suit: suit, Value: value}, nil
+}
+
+/**
+ * Parses a comma-separated list of cards, e.g. "As,Kd,Qc,Jh,Ts".
+ */
+func NewCardsFromString(s string) ([]Card, error) {
+	parts := strings.Split(s, ",")
+	cards := make([]Card, 0, len(parts))
+	for _, part := range parts {
+		c, err := NewCardFromString(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+/**
+ * Parses a concatenated run of two-character card codes with no
+ * separator, e.g. "AsAh" or "AsKsQcJhTh" - the compact form used for hole
+ * and board strings elsewhere in this module.
+ */
+func NewCardsFromShortString(s string) ([]Card, error) {
+	runes := []rune(s)
+	if len(runes)%2 != 0 {
+		return nil, fmt.Errorf("card run %q has an odd number of characters", s)
+	}
+
+	cards := make([]Card, 0, len(runes)/2)
+	for i := 0; i < len(runes); i += 2 {
+		c, err := NewCardFromString(string(runes[i : i+2]))
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, c)
+	}
+	return cards, nil
+}
+
+func suitFromRune(r rune) (Suit, error) {
+	switch r {
+	case 's', 'S', '♠':
+		return Spades, nil
+	case 'h', 'H', '♥':
+		return Hearts, nil
+	case 'd', 'D', '♦':
+		return Diamonds, nil
+	case 'c', 'C', '♣':
+		return Clubs, nil
+	default:
+		return 0, fmt.Errorf("unknown suit %q", r)
+	}
+}
+
+func valueFromString(s string) (int, error) {
+	switch strings.ToUpper(s) {
+	case "A":
+		return 1, nil
+	case "T":
+		return 10, nil
+	case "J":
+		return 11, nil
+	case "Q":
+		return 12, nil
+	case "K":
+		return 13, nil
+	}
+
+	value, err := strconv.Atoi(s)
+	if err != nil || value < 1 || value > 13 {
+		return 0, fmt.Errorf("unknown rank %q", s)
+	}
+	return value, nil
+}
+
 // MARK: Methods
 
 func (c Card) String() string {
+	if c.IsJoker() {
+		return "🃏"
+	}
+
 	values := []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K"}
 	suits := []string{"♠", "♥", "♦", "♣"}
 
@@ -32,9 +147,87 @@ func (c Card) String() string {
 	return fmt.Sprintf("%s%s", values[c.Value-1], suits[c.Suit])
 }
 
+/**
+ * Short returns the compact two-rune ASCII form of the card, e.g. "As" or
+ * "Th" — the inverse of NewCardFromString.
+ */
+func (c Card) Short() string {
+	if c.IsJoker() {
+		return "Jk"
+	}
+	if !IsValidCard(c) {
+		return "??"
+	}
+
+	values := []string{"A", "2", "3", "4", "5", "6", "7", "8", "9", "T", "J", "Q", "K"}
+	suits := []string{"s", "h", "d", "c"}
+	return values[c.Value-1] + suits[c.Suit]
+}
+
+/**
+ * Style selects how Cards.Format renders a hand of cards as text.
+ */
+type Style int
+
+const (
+	StylePlain   Style = iota // ASCII ranks and suit letters, e.g. "As Kd" - see Short()
+	StyleUnicode              // ASCII ranks with Unicode suit glyphs, e.g. "A♠ K♦" - see String()
+	StyleANSI                 // StyleUnicode with ANSI colors: red hearts/diamonds, default black spades/clubs
+)
+
+const (
+	ansiRed   = "\x1b[31m"
+	ansiReset = "\x1b[0m"
+)
+
+/**
+ * Cards is a hand of cards that knows how to render itself as text in a
+ * caller-chosen Style, so the same hand can be shown in a terminal,
+ * written to a plain log, or (via a caller's own encoding) marshaled to
+ * JSON.
+ */
+type Cards []Card
+
+/**
+ * Format renders every card in order, space-separated, using the given
+ * Style.
+ */
+func (cs Cards) Format(style Style) string {
+	parts := make([]string, len(cs))
+	for i, c := range cs {
+		parts[i] = c.format(style)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (c Card) format(style Style) string {
+	switch style {
+	case StyleANSI:
+		s := c.String()
+		if c.Suit == Hearts || c.Suit == Diamonds {
+			return ansiRed + s + ansiReset
+		}
+		return s
+	case StyleUnicode:
+		return c.String()
+	default:
+		return c.Short()
+	}
+}
+
+/**
+ * Reports whether the card is a joker/wildcard rather than a standard card.
+ */
+func (c Card) IsJoker() bool {
+	return c.Suit == JokerSuit
+}
+
 /**
  * Checks if a card has valid suit and value.
  */
 func IsValidCard(c Card) bool {
+	if c.Suit == JokerSuit {
+		return c.Value == 0
+	}
 	return c.Suit >= Spades && c.Suit <= Clubs && c.Value >= 1 && c.Value <= 13
 }
@@ -2,9 +2,11 @@ package cardgame
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/blixxurd/card-game-go/internal/cardgame/card"
 	"github.com/blixxurd/card-game-go/internal/cardgame/deck"
+	"github.com/blixxurd/card-game-go/internal/games/poker/pokerhand"
 )
 
 // MARK: Types
@@ -14,25 +16,79 @@ type Game struct {
 	Deck          deck.Deck
 	Hands         []Hand
 	ReferenceDeck deck.Deck // Full copy of the original deck for verification
+	Evaluator     pokerhand.Evaluator
+	ShuffleProof  *deck.CommitRevealShuffler // set when the deck was dealt under a commit/reveal shuffle
+	DealtOrder    deck.Deck                  // snapshot of the shuffled deck before any cards were drawn
 }
 
 // MARK: Functions
 
 /**
- * Creates a new CardGame with the specified number of hands.
+ * Creates a new CardGame with the specified number of hands, defaulting to
+ * standard Texas Hold'em hand evaluation.
  */
 func NewGame(numHands int) *Game {
+	return NewGameWithEvaluator(numHands, pokerhand.HoldemEvaluator{})
+}
+
+/**
+ * Creates a new CardGame with the specified number of hands and a chosen
+ * Evaluator, so variants such as Omaha, stud, or wildcard games can share
+ * the same deck/hand plumbing as Hold'em.
+ */
+func NewGameWithEvaluator(numHands int, evaluator pokerhand.Evaluator) *Game {
+	game := newGame(numHands, evaluator)
+	game.Deck.Shuffle()
+	return game
+}
+
+/**
+ * Creates a new CardGame dealt from a deterministically shuffled deck, so
+ * callers can reconstruct the exact same deal from the same seed - useful
+ * for pinning a bug report or a unit test to a specific hand.
+ */
+func NewGameWithSeed(numHands int, seed int64) *Game {
+	game := newGame(numHands, pokerhand.HoldemEvaluator{})
+	game.Deck.ShuffleDeterministically(seed)
+	return game
+}
+
+func newGame(numHands int, evaluator pokerhand.Evaluator) *Game {
 	newDeck := deck.NewDeck()
 	referenceDeck := make(deck.Deck, len(newDeck))
 	copy(referenceDeck, newDeck)
 
-	game := &Game{
+	return &Game{
 		Deck:          newDeck,
 		Hands:         make([]Hand, numHands),
 		ReferenceDeck: referenceDeck,
+		Evaluator:     evaluator,
 	}
-	game.Deck.Shuffle()
-	return game
+}
+
+/**
+ * Creates a new CardGame dealt under a commit/reveal shuffle: the
+ * commitment is published (via the returned Game's ShuffleProof) before
+ * any cards are dealt, and the seed/salt it carries can be revealed later
+ * so VerifyHands can prove the dealt order matches the committed shuffle.
+ */
+func NewGameWithCommitReveal(numHands int) (*Game, error) {
+	game := NewGame(numHands)
+
+	proof, err := deck.NewCommitRevealShuffler()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create commit-reveal shuffler: %v", err)
+	}
+
+	game.Deck = make(deck.Deck, len(game.ReferenceDeck))
+	copy(game.Deck, game.ReferenceDeck)
+	proof.Shuffle(game.Deck)
+
+	game.DealtOrder = make(deck.Deck, len(game.Deck))
+	copy(game.DealtOrder, game.Deck)
+	game.ShuffleProof = proof
+
+	return game, nil
 }
 
 /**
@@ -78,7 +134,42 @@ func (g *Game) VerifyHands() (bool, []int) {
 		}
 	}
 
-	return len(invalidHandIndices) == 0, invalidHandIndices
+	valid := len(invalidHandIndices) == 0
+	if g.ShuffleProof != nil && !g.VerifyShuffle() {
+		valid = false
+	}
+
+	return valid, invalidHandIndices
+}
+
+/**
+ * Verifies that the game's revealed shuffle commitment matches the
+ * published commitment, and that replaying the committed seed against the
+ * reference deck reproduces the exact order the cards were dealt from.
+ * Returns false if the game was not dealt under a commit/reveal shuffle.
+ */
+func (g *Game) VerifyShuffle() bool {
+	if g.ShuffleProof == nil || g.DealtOrder == nil {
+		return false
+	}
+	if !g.ShuffleProof.Verify() {
+		return false
+	}
+
+	replay := make(deck.Deck, len(g.ReferenceDeck))
+	copy(replay, g.ReferenceDeck)
+	replay.ShuffleDeterministically(g.ShuffleProof.Seed)
+
+	if len(replay) != len(g.DealtOrder) {
+		return false
+	}
+	for i := range replay {
+		if replay[i] != g.DealtOrder[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
 // MARK: Methods
@@ -0,0 +1,33 @@
+package cardgame
+
+import "testing"
+
+func TestVerifyShuffleDetectsAlteredDealtOrder(t *testing.T) {
+	game, err := NewGameWithCommitReveal(2)
+	if err != nil {
+		t.Fatalf("NewGameWithCommitReveal: %v", err)
+	}
+
+	if !game.VerifyShuffle() {
+		t.Fatalf("expected a freshly dealt commit-reveal shuffle to verify")
+	}
+
+	game.DealtOrder[0], game.DealtOrder[1] = game.DealtOrder[1], game.DealtOrder[0]
+
+	if game.VerifyShuffle() {
+		t.Fatalf("expected VerifyShuffle to fail once DealtOrder was altered post-commit")
+	}
+}
+
+func TestVerifyShuffleDetectsTamperedCommitment(t *testing.T) {
+	game, err := NewGameWithCommitReveal(2)
+	if err != nil {
+		t.Fatalf("NewGameWithCommitReveal: %v", err)
+	}
+
+	game.ShuffleProof.Seed++ // simulate the seed being revealed differently from what was committed
+
+	if game.VerifyShuffle() {
+		t.Fatalf("expected VerifyShuffle to fail once the revealed seed no longer matches the commitment")
+	}
+}
@@ -2,8 +2,6 @@ package deck
 
 import (
 	"errors"
-	"math/rand"
-	"time"
 
 	"github.com/blixxurd/card-game-go/internal/cardgame/card"
 )
@@ -22,16 +20,44 @@ func NewDeck() Deck {
 	return deck
 }
 
+/**
+ * Builds a Deck from a comma-separated card string, e.g.
+ * "As,Kd,Qc,Jh,Ts". Useful for scripting fixed test fixtures without
+ * manipulating a full 52-card deck by hand.
+ */
+func NewDeckFromString(s string) (Deck, error) {
+	cards, err := card.NewCardsFromString(s)
+	if err != nil {
+		return nil, err
+	}
+	return Deck(cards), nil
+}
+
 // MARK: Methods
 
 /**
- * Shuffles the Deck.
+ * Shuffles the Deck using a CryptoShuffler. Unlike the old time-seeded
+ * math/rand approach, this is safe to call concurrently across decks and
+ * cannot be predicted by an observer.
  */
 func (d Deck) Shuffle() {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(d), func(i, j int) {
-		d[i], d[j] = d[j], d[i]
-	})
+	CryptoShuffler{}.Shuffle(d)
+}
+
+/**
+ * Shuffles the Deck deterministically from the given seed, so the exact
+ * same permutation can be reproduced later (tests, bug repros, or
+ * replaying a committed shuffle for verification).
+ */
+func (d Deck) ShuffleDeterministically(seed int64) {
+	MathShuffler{Seed: seed}.Shuffle(d)
+}
+
+/**
+ * Shuffles the Deck using the supplied Shuffler.
+ */
+func (d Deck) ShuffleWith(s Shuffler) {
+	s.Shuffle(d)
 }
 
 /**
@@ -0,0 +1,32 @@
+package deck
+
+import "testing"
+
+func TestCommitRevealShufflerVerifyDetectsTamperedSeed(t *testing.T) {
+	s, err := NewCommitRevealShuffler()
+	if err != nil {
+		t.Fatalf("NewCommitRevealShuffler: %v", err)
+	}
+	if !s.Verify() {
+		t.Fatalf("expected a freshly generated shuffler to verify")
+	}
+
+	s.Seed++ // simulate the seed being altered after the commitment was published
+
+	if s.Verify() {
+		t.Fatalf("expected Verify to fail after the seed was tampered with post-commitment")
+	}
+}
+
+func TestCommitRevealShufflerVerifyDetectsTamperedSalt(t *testing.T) {
+	s, err := NewCommitRevealShuffler()
+	if err != nil {
+		t.Fatalf("NewCommitRevealShuffler: %v", err)
+	}
+
+	s.Salt[0] ^= 0xFF // simulate the salt being altered after the commitment was published
+
+	if s.Verify() {
+		t.Fatalf("expected Verify to fail after the salt was tampered with post-commitment")
+	}
+}
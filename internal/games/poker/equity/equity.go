@@ -0,0 +1,325 @@
+package equity
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+	"github.com/blixxurd/card-game-go/internal/cardgame/deck"
+	"github.com/blixxurd/card-game-go/internal/games/poker/pokerhand"
+)
+
+// MARK: Types
+
+/**
+ * EquityResult summarizes one player's share of outcomes across every
+ * trial of an Equity run: how often they win outright, their average share
+ * of the pot from ties (an n-way tie counts 1/n per trial, so TiePercent is
+ * a pot share rather than a tie frequency), and which hand class (pair,
+ * flush, ...) they ended up making.
+ */
+type EquityResult struct {
+	WinPercent       float64
+	TiePercent       float64
+	HandClassPercent map[pokerhand.HandRank]float64
+}
+
+// MARK: Functions
+
+/**
+ * exactEnumerationLimit is the largest number of board completions Equity
+ * will enumerate exhaustively. Above this, it falls back to Monte Carlo
+ * sampling - e.g. a fully empty preflop board with two players leaves
+ * C(48,5) = 1,712,304 completions, just under the limit. Each extra player
+ * removes two more cards from the unknowns, so the count only shrinks with
+ * more players; a lone player on an empty board, C(50,5) = 2,118,760, is
+ * the case that goes past it.
+ */
+const exactEnumerationLimit = 2_000_000
+
+/**
+ * Equity estimates each player's win %, tie %, and hand-class distribution
+ * given their hole cards and a partial community board (0, 3, 4, or 5
+ * cards known). It deals the remaining community cards from the deck
+ * minus every known card, evaluates each player's best hand with
+ * pokerhand.EvaluateBestHand, and tallies the outcome.
+ *
+ * When the number of possible board completions is at most
+ * exactEnumerationLimit, every completion is enumerated exactly; otherwise
+ * iterations Monte Carlo trials are run in parallel across
+ * runtime.NumCPU() workers, each with its own RNG.
+ */
+func Equity(holeCards [][]card.Card, board []card.Card, iterations int) []EquityResult {
+	remaining := 5 - len(board)
+	if remaining < 0 || len(holeCards) == 0 {
+		return nil
+	}
+
+	unknown := unknownCards(holeCards, board)
+
+	tallies := make([]tally, len(holeCards))
+	for i := range tallies {
+		tallies[i].handClassCounts = make(map[pokerhand.HandRank]int)
+	}
+
+	var trials int
+	if combinationCount(len(unknown), remaining) <= exactEnumerationLimit {
+		trials = enumerateExact(holeCards, board, unknown, remaining, tallies)
+	} else {
+		trials = sampleMonteCarlo(holeCards, board, unknown, remaining, iterations, tallies)
+	}
+
+	return summarize(tallies, trials)
+}
+
+/**
+ * tally accumulates one player's raw outcome counts across every trial,
+ * before Equity converts them to percentages.
+ */
+type tally struct {
+	wins            float64
+	ties            float64
+	handClassCounts map[pokerhand.HandRank]int
+}
+
+func enumerateExact(holeCards [][]card.Card, board []card.Card, unknown []card.Card, remaining int, tallies []tally) int {
+	if remaining == 0 {
+		record(holeCards, board, tallies)
+		return 1
+	}
+
+	combos := pokerhand.GenerateCombinations(unknown, remaining)
+
+	workers := runtime.NumCPU()
+	if workers > len(combos) {
+		workers = len(combos)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	chunk := (len(combos) + workers - 1) / workers
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	for start := 0; start < len(combos); start += chunk {
+		end := start + chunk
+		if end > len(combos) {
+			end = len(combos)
+		}
+
+		wg.Add(1)
+		go func(combos [][]card.Card) {
+			defer wg.Done()
+
+			local := make([]tally, len(holeCards))
+			for i := range local {
+				local[i].handClassCounts = make(map[pokerhand.HandRank]int)
+			}
+
+			for _, combo := range combos {
+				completed := make([]card.Card, 0, len(board)+remaining)
+				completed = append(completed, board...)
+				completed = append(completed, combo...)
+				record(holeCards, completed, local)
+			}
+
+			mu.Lock()
+			for i := range tallies {
+				tallies[i].wins += local[i].wins
+				tallies[i].ties += local[i].ties
+				for rank, count := range local[i].handClassCounts {
+					tallies[i].handClassCounts[rank] += count
+				}
+			}
+			mu.Unlock()
+		}(combos[start:end])
+	}
+
+	wg.Wait()
+	return len(combos)
+}
+
+func sampleMonteCarlo(holeCards [][]card.Card, board []card.Card, unknown []card.Card, remaining int, iterations int, tallies []tally) int {
+	workers := runtime.NumCPU()
+	if workers > iterations {
+		workers = iterations
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	perWorker := iterations / workers
+	extra := iterations % workers
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		n := perWorker
+		if w < extra {
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(n int, seed int64) {
+			defer wg.Done()
+
+			r := rand.New(rand.NewSource(seed))
+			local := make([]tally, len(holeCards))
+			for i := range local {
+				local[i].handClassCounts = make(map[pokerhand.HandRank]int)
+			}
+
+			for i := 0; i < n; i++ {
+				record(holeCards, completeBoard(r, board, unknown, remaining), local)
+			}
+
+			mu.Lock()
+			for i := range tallies {
+				tallies[i].wins += local[i].wins
+				tallies[i].ties += local[i].ties
+				for rank, count := range local[i].handClassCounts {
+					tallies[i].handClassCounts[rank] += count
+				}
+			}
+			mu.Unlock()
+		}(n, time.Now().UnixNano()+int64(w))
+	}
+
+	wg.Wait()
+	return iterations
+}
+
+/**
+ * record evaluates every player's best hand against the given complete
+ * board and updates their tally: a win for the sole best hand, a tie
+ * split among co-best hands, and a hand-class count for whatever they
+ * made regardless of who won.
+ */
+func record(holeCards [][]card.Card, board []card.Card, tallies []tally) {
+	results := make([]pokerhand.HandResult, len(holeCards))
+	var best pokerhand.HandScore
+	haveBest := false
+
+	for i, hole := range holeCards {
+		all := make([]card.Card, 0, len(hole)+len(board))
+		all = append(all, hole...)
+		all = append(all, board...)
+
+		result, err := pokerhand.EvaluateBestHand(all)
+		if err != nil {
+			continue
+		}
+		results[i] = result
+		tallies[i].handClassCounts[result.Rank]++
+
+		score := result.Score()
+		if !haveBest || score > best {
+			best = score
+			haveBest = true
+		}
+	}
+
+	if !haveBest {
+		return
+	}
+
+	winners := 0
+	for _, result := range results {
+		if result.Score() == best {
+			winners++
+		}
+	}
+
+	for i, result := range results {
+		if result.Score() != best {
+			continue
+		}
+		if winners == 1 {
+			tallies[i].wins++
+		} else {
+			tallies[i].ties += 1.0 / float64(winners)
+		}
+	}
+}
+
+func summarize(tallies []tally, trials int) []EquityResult {
+	results := make([]EquityResult, len(tallies))
+	if trials == 0 {
+		for i := range results {
+			results[i].HandClassPercent = map[pokerhand.HandRank]float64{}
+		}
+		return results
+	}
+
+	for i, t := range tallies {
+		classPercent := make(map[pokerhand.HandRank]float64, len(t.handClassCounts))
+		for rank, count := range t.handClassCounts {
+			classPercent[rank] = float64(count) / float64(trials)
+		}
+		results[i] = EquityResult{
+			WinPercent:       t.wins / float64(trials),
+			TiePercent:       t.ties / float64(trials),
+			HandClassPercent: classPercent,
+		}
+	}
+	return results
+}
+
+func completeBoard(r *rand.Rand, board []card.Card, unknown []card.Card, remaining int) []card.Card {
+	shuffled := make([]card.Card, len(unknown))
+	copy(shuffled, unknown)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	completed := make([]card.Card, len(board), len(board)+remaining)
+	copy(completed, board)
+	return append(completed, shuffled[:remaining]...)
+}
+
+func unknownCards(holeCards [][]card.Card, board []card.Card) []card.Card {
+	used := make(map[card.Card]bool)
+	for _, hole := range holeCards {
+		for _, c := range hole {
+			used[c] = true
+		}
+	}
+	for _, c := range board {
+		used[c] = true
+	}
+
+	full := deck.NewDeck()
+	unknown := make([]card.Card, 0, len(full)-len(used))
+	for _, c := range full {
+		if !used[c] {
+			unknown = append(unknown, c)
+		}
+	}
+	return unknown
+}
+
+/**
+ * combinationCount returns n choose k, the number of ways to complete the
+ * board from the unknown cards, without generating them - used to decide
+ * whether exact enumeration is cheap enough to run.
+ */
+func combinationCount(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	if k > n-k {
+		k = n - k
+	}
+	count := 1
+	for i := 0; i < k; i++ {
+		count = count * (n - i) / (i + 1)
+	}
+	return count
+}
@@ -0,0 +1,436 @@
+package holdem
+
+import (
+	"fmt"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/deck"
+)
+
+// MARK: Types
+
+/**
+ * Street identifies which betting round a HoldemGame is in.
+ */
+type Street string
+
+const (
+	StreetPreflop  Street = "preflop"
+	StreetFlop     Street = "flop"
+	StreetTurn     Street = "turn"
+	StreetRiver    Street = "river"
+	StreetShowdown Street = "showdown"
+)
+
+/**
+ * PlayerStatus is the state of a single player within the current hand.
+ */
+type PlayerStatus string
+
+const (
+	PlayerActive PlayerStatus = "active"
+	PlayerFolded PlayerStatus = "folded"
+	PlayerAllIn  PlayerStatus = "all_in"
+)
+
+/**
+ * Action is a betting action a player can take when it is their turn. An
+ * all-in is not its own Action - it happens automatically when a Call,
+ * Bet, or Raise amount reaches or exceeds the player's remaining Stack.
+ */
+type Action string
+
+const (
+	ActionFold  Action = "fold"
+	ActionCheck Action = "check"
+	ActionCall  Action = "call"
+	ActionBet   Action = "bet"
+	ActionRaise Action = "raise"
+)
+
+/**
+ * Player tracks one player's chip stack and betting state across a hand.
+ */
+type Player struct {
+	Stack       int
+	Bet         int // chips committed on the current street
+	Contributed int // chips committed across the whole hand, for side pots
+	Status      PlayerStatus
+}
+
+/**
+ * EventType identifies what happened in a BettingEvent.
+ */
+type EventType string
+
+const (
+	EventBlindPosted    EventType = "blind_posted"
+	EventPlayerActed    EventType = "player_acted"
+	EventStreetAdvanced EventType = "street_advanced"
+	EventHandEnded      EventType = "hand_ended"
+)
+
+/**
+ * BettingEvent is emitted to HoldemGame.OnEvent as a hand progresses.
+ * Player is the 1-based player number from PlayerHand.Player; it is zero
+ * for events, such as EventStreetAdvanced, that aren't about one player.
+ */
+type BettingEvent struct {
+	Type   EventType
+	Player int
+	Action Action
+	Amount int
+	Street Street
+}
+
+// MARK: Methods
+
+/**
+ * StartBettingHand begins a new hand with betting: it reshuffles a fresh
+ * deck, resets the pot, community cards, and every player's hole cards,
+ * deals new hole cards, and posts blinds, leaving ActionOn set to the
+ * first player to act preflop. On the first call it buys every player in
+ * for startingStack chips; on later calls it reuses each player's current
+ * Stack, so a caller can run consecutive hands at a table.
+ */
+func (g *HoldemGame) StartBettingHand(startingStack, smallBlind, bigBlind int) error {
+	if g.Players == nil {
+		g.Players = make([]*Player, g.NumPlayers)
+		for i := range g.Players {
+			g.Players[i] = &Player{Stack: startingStack}
+		}
+	}
+	for _, p := range g.Players {
+		p.Bet = 0
+		p.Contributed = 0
+		p.Status = PlayerActive
+	}
+
+	g.Game.Deck = make(deck.Deck, len(g.Game.ReferenceDeck))
+	copy(g.Game.Deck, g.Game.ReferenceDeck)
+	g.Game.Deck.Shuffle()
+	for i := range g.Game.Hands {
+		g.Game.Hands[i] = nil
+	}
+
+	g.CommunityCards = g.CommunityCards[:0]
+	g.Pot = newPot()
+	g.Street = StreetPreflop
+	g.SmallBlind = smallBlind
+	g.BigBlind = bigBlind
+	g.MinRaise = bigBlind
+
+	if err := g.DealHoleCards(); err != nil {
+		return err
+	}
+
+	// Heads-up is the exception to button-is-last-to-act-preflop: with
+	// only two players the button is the small blind and acts first
+	// preflop (and last on every later street), rather than skipping
+	// past to a separate small blind seat.
+	var sbIdx, bbIdx int
+	if g.NumPlayers == 2 {
+		sbIdx = g.ButtonSeat
+		bbIdx = g.nextActivePlayer(g.ButtonSeat)
+	} else {
+		sbIdx = g.nextActivePlayer(g.ButtonSeat)
+		bbIdx = g.nextActivePlayer(sbIdx)
+	}
+	g.postBlind(sbIdx, smallBlind)
+	g.postBlind(bbIdx, bigBlind)
+	g.CurrentBet = bigBlind
+
+	if g.NumPlayers == 2 {
+		g.ActionOn = sbIdx
+	} else {
+		g.ActionOn = g.nextActivePlayer(bbIdx)
+	}
+	g.toAct = len(g.activePlayers())
+
+	return nil
+}
+
+func (g *HoldemGame) postBlind(playerIdx, amount int) {
+	p := g.Players[playerIdx]
+	posted := amount
+	if posted >= p.Stack {
+		posted = p.Stack
+		p.Status = PlayerAllIn
+	}
+	p.Stack -= posted
+	p.Bet += posted
+	p.Contributed += posted
+	g.Pot.Contribute(playerIdx+1, posted)
+	g.emit(BettingEvent{Type: EventBlindPosted, Player: playerIdx + 1, Amount: posted, Street: g.Street})
+}
+
+/**
+ * DealFlop burns one card and deals three community cards, per standard
+ * Hold'em rules.
+ */
+func (g *HoldemGame) DealFlop() error {
+	if _, err := g.Game.Deck.Draw(); err != nil {
+		return fmt.Errorf("error burning card: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		c, err := g.Game.Deck.Draw()
+		if err != nil {
+			return fmt.Errorf("error dealing flop: %v", err)
+		}
+		g.CommunityCards = append(g.CommunityCards, c)
+	}
+	g.Street = StreetFlop
+	return nil
+}
+
+/**
+ * DealTurn burns one card and deals the turn card.
+ */
+func (g *HoldemGame) DealTurn() error {
+	return g.dealTurnOrRiver(StreetTurn)
+}
+
+/**
+ * DealRiver burns one card and deals the river card.
+ */
+func (g *HoldemGame) DealRiver() error {
+	return g.dealTurnOrRiver(StreetRiver)
+}
+
+func (g *HoldemGame) dealTurnOrRiver(street Street) error {
+	if _, err := g.Game.Deck.Draw(); err != nil {
+		return fmt.Errorf("error burning card: %v", err)
+	}
+	c, err := g.Game.Deck.Draw()
+	if err != nil {
+		return fmt.Errorf("error dealing %s: %v", street, err)
+	}
+	g.CommunityCards = append(g.CommunityCards, c)
+	g.Street = street
+	return nil
+}
+
+/**
+ * Act applies one player's betting action, enforcing turn order and
+ * minimum raise rules. It automatically advances the street once every
+ * player still able to act (not folded, not all-in) has acted and bets are
+ * matched, and moves to showdown
+ * once at most one player remains.
+ */
+func (g *HoldemGame) Act(playerIdx int, action Action, amount int) error {
+	if playerIdx != g.ActionOn {
+		return fmt.Errorf("it is not player %d's turn to act", playerIdx+1)
+	}
+
+	p := g.Players[playerIdx]
+	toCall := g.CurrentBet - p.Bet
+
+	switch action {
+	case ActionFold:
+		p.Status = PlayerFolded
+		g.Pot.Fold(playerIdx + 1)
+
+	case ActionCheck:
+		if toCall != 0 {
+			return fmt.Errorf("cannot check, %d is owed to call", toCall)
+		}
+
+	case ActionCall:
+		call := toCall
+		if call >= p.Stack {
+			call = p.Stack
+			p.Status = PlayerAllIn
+		}
+		p.Stack -= call
+		p.Bet += call
+		p.Contributed += call
+		g.Pot.Contribute(playerIdx+1, call)
+
+	case ActionBet, ActionRaise:
+		if amount < g.CurrentBet+g.MinRaise && amount < p.Bet+p.Stack {
+			return fmt.Errorf("raise to %d is below the minimum raise of %d", amount, g.CurrentBet+g.MinRaise)
+		}
+		raiseBy := amount - g.CurrentBet
+		delta := amount - p.Bet
+		if delta >= p.Stack {
+			delta = p.Stack
+			p.Status = PlayerAllIn
+		}
+		p.Stack -= delta
+		p.Bet += delta
+		p.Contributed += delta
+		g.Pot.Contribute(playerIdx+1, delta)
+		g.CurrentBet = p.Bet
+		// An all-in for less than a full raise doesn't reopen betting for
+		// players who've already called the prior bet - only a raise that
+		// meets or exceeds MinRaise does.
+		reopensAction := raiseBy >= g.MinRaise
+		if raiseBy > g.MinRaise {
+			g.MinRaise = raiseBy
+		}
+		if reopensAction {
+			g.toAct = g.otherActivePlayers(playerIdx) + 1 // raise reopens action for everyone else; +1 offsets the g.toAct-- below for this action
+		}
+
+	default:
+		return fmt.Errorf("unsupported action %q", action)
+	}
+
+	g.emit(BettingEvent{Type: EventPlayerActed, Player: playerIdx + 1, Action: action, Amount: amount, Street: g.Street})
+
+	g.toAct--
+
+	if len(g.contestedPlayers()) <= 1 {
+		return g.endBettingHand()
+	}
+	if g.toAct <= 0 {
+		return g.advanceStreet()
+	}
+
+	g.ActionOn = g.nextPlayerAmong(g.ActionOn, g.activePlayers())
+	return nil
+}
+
+func (g *HoldemGame) advanceStreet() error {
+	for _, p := range g.Players {
+		p.Bet = 0
+	}
+	g.CurrentBet = 0
+	g.MinRaise = g.BigBlind
+
+	var err error
+	switch g.Street {
+	case StreetPreflop:
+		err = g.DealFlop()
+	case StreetFlop:
+		err = g.DealTurn()
+	case StreetTurn:
+		err = g.DealRiver()
+	default:
+		return g.endBettingHand()
+	}
+	if err != nil {
+		return err
+	}
+
+	g.emit(BettingEvent{Type: EventStreetAdvanced, Street: g.Street})
+
+	contested := g.contestedPlayers()
+	if len(contested) <= 1 {
+		return g.endBettingHand()
+	}
+
+	// If fewer than two players can still act, everyone left is all-in -
+	// there's no decision for Act to drive, so run the board out to
+	// showdown instead of stalling with toAct at zero.
+	if active := g.activePlayers(); len(active) < 2 {
+		return g.advanceStreet()
+	}
+
+	g.ActionOn = g.nextPlayerAmong(g.ButtonSeat, g.activePlayers())
+	g.toAct = len(g.activePlayers())
+	return nil
+}
+
+func (g *HoldemGame) endBettingHand() error {
+	g.Street = StreetShowdown
+
+	// With everyone else folded, the last contested player wins the pot
+	// uncontested - there is nothing to evaluate, and the community cards
+	// may not even be out yet (e.g. everyone folds to a preflop raise).
+	if contested := g.contestedPlayers(); len(contested) <= 1 {
+		g.awardUncontestedPot(contested)
+		g.emit(BettingEvent{Type: EventHandEnded, Street: g.Street})
+		return nil
+	}
+
+	if err := g.EvaluateHands(); err != nil {
+		return err
+	}
+
+	for _, winner := range g.DetermineWinner() {
+		g.Players[winner.Player-1].Stack += winner.Payout
+	}
+
+	g.emit(BettingEvent{Type: EventHandEnded, Street: g.Street})
+	return nil
+}
+
+// awardUncontestedPot pays the whole pot to the sole remaining contested
+// player, if any, without evaluating hands. contested is the (at most
+// one-element) result of contestedPlayers.
+func (g *HoldemGame) awardUncontestedPot(contested []int) {
+	for i := range g.PlayerHands {
+		g.PlayerHands[i].Payout = 0
+	}
+	if len(contested) == 0 {
+		return
+	}
+
+	winnerIdx := contested[0]
+	amount := g.Pot.total()
+	g.Players[winnerIdx].Stack += amount
+	g.PlayerHands[winnerIdx].Payout = amount
+}
+
+func (g *HoldemGame) emit(event BettingEvent) {
+	if g.OnEvent != nil {
+		g.OnEvent(event)
+	}
+}
+
+// activePlayers are players who can still act this street.
+func (g *HoldemGame) activePlayers() []int {
+	var idx []int
+	for i, p := range g.Players {
+		if p.Status == PlayerActive {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+// otherActivePlayers counts players who can still act this street, not
+// counting playerIdx. Used when a bet/raise reopens action: playerIdx may
+// still be PlayerActive (so activePlayers would double-count them) or may
+// have just gone PlayerAllIn (so activePlayers would already exclude them) -
+// counting by index instead of re-deriving from Status keeps both cases the
+// same, regardless of whether the actor is still counted as active.
+func (g *HoldemGame) otherActivePlayers(playerIdx int) int {
+	count := 0
+	for i, p := range g.Players {
+		if i != playerIdx && p.Status == PlayerActive {
+			count++
+		}
+	}
+	return count
+}
+
+// contestedPlayers are players still able to win the hand: not folded.
+func (g *HoldemGame) contestedPlayers() []int {
+	var idx []int
+	for i, p := range g.Players {
+		if p.Status == PlayerActive || p.Status == PlayerAllIn {
+			idx = append(idx, i)
+		}
+	}
+	return idx
+}
+
+func (g *HoldemGame) nextActivePlayer(from int) int {
+	return g.nextPlayerAmong(from, g.activePlayers())
+}
+
+func (g *HoldemGame) nextPlayerAmong(from int, within []int) int {
+	inSet := make(map[int]bool, len(within))
+	for _, i := range within {
+		inSet[i] = true
+	}
+
+	for i := 1; i <= len(g.Players); i++ {
+		idx := (from + i) % len(g.Players)
+		if inSet[idx] {
+			return idx
+		}
+	}
+	return from
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/blixxurd/card-game-go/internal/cardgame"
 	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+	"github.com/blixxurd/card-game-go/internal/cardgame/deck"
 	"github.com/blixxurd/card-game-go/internal/games/poker/pokerhand"
+	"github.com/blixxurd/card-game-go/internal/games/poker/pot"
 )
 
 // MARK: Types
@@ -13,6 +15,7 @@ type PlayerHand struct {
 	Player     int
 	HoleCards  []card.Card
 	HandResult pokerhand.HandResult
+	Payout     int // chips won at showdown, filled in by DetermineWinner
 }
 
 type HoldemGame struct {
@@ -20,8 +23,72 @@ type HoldemGame struct {
 	CommunityCards []card.Card
 	PlayerHands    []PlayerHand
 	NumPlayers     int
+	Pot            Pot
+
+	// Betting state - populated by StartBettingHand. Players is indexed in
+	// parallel with PlayerHands, so Players[i] belongs to player i+1.
+	Players    []*Player
+	Street     Street
+	ButtonSeat int
+	SmallBlind int
+	BigBlind   int
+	CurrentBet int
+	MinRaise   int
+	ActionOn   int
+	toAct      int // players still needing to act before the street closes
+
+	// OnEvent, if set, is called for every BettingEvent as the hand
+	// progresses - a bot, a CLI loop, or a server can hook this instead of
+	// polling game state.
+	OnEvent func(BettingEvent)
+
+	// Formatter renders cards for PrintGameState. Defaults to Unicode suit
+	// glyphs; inject a different Formatter (or a custom implementation, e.g.
+	// one that marshals to JSON) to change how a game is displayed.
+	Formatter Formatter
+}
+
+/**
+ * Formatter renders a hand of cards as text, so PrintGameState can target
+ * a terminal, a plain log, or any other output a caller's Formatter
+ * chooses to produce.
+ */
+type Formatter interface {
+	Format(cards []card.Card) string
+}
+
+/**
+ * StyleFormatter is the default Formatter, rendering cards with a fixed
+ * card.Style.
+ */
+type StyleFormatter struct {
+	Style card.Style
 }
 
+func (f StyleFormatter) Format(cards []card.Card) string {
+	return card.Cards(cards).Format(f.Style)
+}
+
+/**
+ * Pot tracks how many chips each player has contributed to the hand, so
+ * DetermineWinner can split the chips into side pots when a short-stacked
+ * player goes all-in for less than the full bet, and can pay out co-winners
+ * their correct share.
+ */
+type Pot struct {
+	Contributions map[int]int  // player number -> total chips contributed this hand
+	Folded        map[int]bool // player number -> no longer eligible to win any pot
+}
+
+/**
+ * SidePot is a pot eligible to be won only by the players listed in
+ * EligiblePlayers - the mechanism that lets an all-in player compete for
+ * the chips they covered while later, bigger bets form a separate pot
+ * among the remaining players. It is an alias for pot.SidePot, so callers
+ * don't need to import the pot package themselves.
+ */
+type SidePot = pot.SidePot
+
 // MARK: Functions
 
 /**
@@ -33,9 +100,37 @@ func NewGame(numPlayers int) *HoldemGame {
 		CommunityCards: make([]card.Card, 0, 5),
 		PlayerHands:    make([]PlayerHand, numPlayers),
 		NumPlayers:     numPlayers,
+		Pot:            newPot(),
+		Formatter:      StyleFormatter{Style: card.StyleUnicode},
 	}
 }
 
+/**
+ * Creates a new HoldemGame dealt from a deterministically shuffled deck,
+ * so callers can reconstruct the exact same deal from the same seed -
+ * e.g. to pin a bad-beat bug report to a specific hand.
+ */
+func NewGameSeeded(numPlayers int, seed int64) *HoldemGame {
+	return &HoldemGame{
+		Game:           cardgame.NewGameWithSeed(numPlayers, seed),
+		CommunityCards: make([]card.Card, 0, 5),
+		PlayerHands:    make([]PlayerHand, numPlayers),
+		NumPlayers:     numPlayers,
+		Pot:            newPot(),
+		Formatter:      StyleFormatter{Style: card.StyleUnicode},
+	}
+}
+
+/**
+ * Re-shuffles the underlying deck deterministically from the given seed.
+ * Only meaningful before any cards have been dealt.
+ */
+func (g *HoldemGame) ShuffleDeterministically(seed int64) {
+	g.Game.Deck = make(deck.Deck, len(g.Game.ReferenceDeck))
+	copy(g.Game.Deck, g.Game.ReferenceDeck)
+	g.Game.Deck.ShuffleDeterministically(seed)
+}
+
 /**
  * Deals two cards to each player.
  */
@@ -51,6 +146,33 @@ func (g *HoldemGame) DealHoleCards() error {
 	return nil
 }
 
+/**
+ * SetHoleCards overrides player playerIdx's hole cards from a compact
+ * string like "AsAh", removing those cards from the deck so later deals
+ * can't re-draw them. This lets a test or bug repro pin an exact scenario
+ * - e.g. a two-pair-with-bigger-pair kicker bug - without hand-rolling
+ * deck manipulation.
+ */
+func (g *HoldemGame) SetHoleCards(playerIdx int, cards string) error {
+	if playerIdx < 0 || playerIdx >= g.NumPlayers {
+		return fmt.Errorf("invalid player index %d", playerIdx)
+	}
+
+	parsed, err := card.NewCardsFromShortString(cards)
+	if err != nil {
+		return fmt.Errorf("invalid hole cards %q: %v", cards, err)
+	}
+
+	for _, c := range parsed {
+		if !g.Game.Deck.RemoveCard(c) {
+			return fmt.Errorf("card %s is not available to deal - already in play", c.Short())
+		}
+	}
+
+	g.Game.Hands[playerIdx] = cardgame.Hand(parsed)
+	return nil
+}
+
 /**
  * Deals five community cards.
  */
@@ -86,49 +208,147 @@ func (g *HoldemGame) EvaluateHands() error {
 }
 
 /**
- * Determines the winner of the game.
+ * newPot returns an empty Pot ready to track contributions for a hand.
  */
-func (g *HoldemGame) DetermineWinner() PlayerHand {
-	winner := g.PlayerHands[0]
-	for i := 1; i < len(g.PlayerHands); i++ {
-		comparison := pokerhand.CompareHands(g.PlayerHands[i].HandResult, winner.HandResult)
-		if comparison > 0 {
-			winner = g.PlayerHands[i]
-		} else if comparison == 0 {
-			winner = g.breakTie(winner, g.PlayerHands[i])
-		}
+func newPot() Pot {
+	return Pot{
+		Contributions: make(map[int]int),
+		Folded:        make(map[int]bool),
+	}
+}
+
+/**
+ * Contribute records additional chips a player has put into the pot this
+ * hand, e.g. a blind, call, bet, raise, or all-in.
+ */
+func (p *Pot) Contribute(player int, amount int) {
+	p.Contributions[player] += amount
+}
+
+/**
+ * Fold marks a player as no longer eligible to win any pot they
+ * contributed to, without removing their chips from the pot.
+ */
+func (p *Pot) Fold(player int) {
+	p.Folded[player] = true
+}
+
+// total returns every chip contributed to the pot this hand, across all
+// players - the full amount due to a player who wins uncontested.
+func (p *Pot) total() int {
+	total := 0
+	for _, amount := range p.Contributions {
+		total += amount
 	}
-	return winner
+	return total
 }
 
 /**
- * Breaks a tie between two hands by comparing the hole cards.
- * The function compares the hole cards from each hand in order
- * and returns the hand with the higher card.
+ * sidePots splits the pot's total contributions into one or more SidePots,
+ * so that a player who went all-in for less than another player's bet can
+ * only win up to the amount they contributed, with everything above that
+ * forming side pots among the players still able to match it. See
+ * pot.CalculateSidePots for the algorithm, which is shared with the
+ * gameserver's own seat-indexed pot.
  */
-func (g *HoldemGame) breakTie(hand1, hand2 PlayerHand) PlayerHand {
-	for i := 0; i < len(hand1.HoleCards) && i < len(hand2.HoleCards); i++ {
-		value1 := g.getComparisonValue(hand1.HoleCards[i])
-		value2 := g.getComparisonValue(hand2.HoleCards[i])
-		if value1 > value2 {
-			return hand1
-		} else if value2 > value1 {
-			return hand2
+func (p *Pot) sidePots() []SidePot {
+	return pot.CalculateSidePots(p.Contributions, p.Folded)
+}
+
+/**
+ * Determines the winner(s) of the game. All players sharing the best
+ * HandResult after kicker comparison are co-winners and split the pot; if
+ * the pot has contributions recorded (e.g. an all-in for less than a full
+ * bet), each side pot is awarded separately so a short stack can only win
+ * the chips it covered. Returned hands carry their Payout; hands that did
+ * not win anything have a Payout of zero. If no contributions were
+ * recorded at all (the betting API was never used to fund a pot),
+ * winners are still identified but every Payout is zero - callers should
+ * check Pot.total() before treating Payout as a real chip amount.
+ */
+func (g *HoldemGame) DetermineWinner() []PlayerHand {
+	byPlayer := make(map[int]*PlayerHand, len(g.PlayerHands))
+	for i := range g.PlayerHands {
+		g.PlayerHands[i].Payout = 0
+		byPlayer[g.PlayerHands[i].Player] = &g.PlayerHands[i]
+	}
+
+	pots := g.Pot.sidePots()
+	if len(pots) == 0 {
+		// No contributions were recorded for this hand - fall back to a
+		// single pot contested by every dealt-in player.
+		eligible := make([]int, len(g.PlayerHands))
+		for i, ph := range g.PlayerHands {
+			eligible[i] = ph.Player
 		}
+		pots = []SidePot{{EligiblePlayers: eligible}}
 	}
-	return hand1
+
+	winningPlayers := map[int]bool{}
+	for _, pot := range pots {
+		winners := g.bestPlayers(pot.EligiblePlayers)
+		if len(winners) == 0 {
+			continue
+		}
+		share := pot.Amount / len(winners)
+		remainder := pot.Amount % len(winners)
+		for i, player := range winners {
+			amount := share
+			if i < remainder {
+				amount++
+			}
+			byPlayer[player].Payout += amount
+			winningPlayers[player] = true
+		}
+	}
+
+	result := make([]PlayerHand, 0, len(winningPlayers))
+	for _, ph := range g.PlayerHands {
+		if winningPlayers[ph.Player] {
+			result = append(result, ph)
+		}
+	}
+	return result
 }
 
 /**
- * Returns the comparison value of a card.
- * The comparison value is the card value, with the exception
- * of the Ace, which is assigned a value of 14 for comparison purposes.
+ * bestPlayers returns every eligible player sharing the best HandResult,
+ * per pokerhand.CompareHands. Players who folded before showdown have no
+ * HandResult and are skipped.
  */
-func (g *HoldemGame) getComparisonValue(card card.Card) int {
-	if card.Value == 1 { // Ace
-		return 14
+func (g *HoldemGame) bestPlayers(eligible []int) []int {
+	byPlayer := make(map[int]pokerhand.HandResult, len(g.PlayerHands))
+	for _, ph := range g.PlayerHands {
+		if !g.Pot.Folded[ph.Player] {
+			byPlayer[ph.Player] = ph.HandResult
+		}
 	}
-	return card.Value
+
+	var best []int
+	var bestResult pokerhand.HandResult
+	haveBest := false
+
+	for _, player := range eligible {
+		result, ok := byPlayer[player]
+		if !ok {
+			continue
+		}
+		if !haveBest {
+			best = []int{player}
+			bestResult = result
+			haveBest = true
+			continue
+		}
+		switch cmp := pokerhand.CompareHands(result, bestResult); {
+		case cmp > 0:
+			best = []int{player}
+			bestResult = result
+		case cmp == 0:
+			best = append(best, player)
+		}
+	}
+
+	return best
 }
 
 /**
@@ -136,13 +356,13 @@ func (g *HoldemGame) getComparisonValue(card card.Card) int {
  */
 func (g *HoldemGame) PrintGameState() {
 	fmt.Println("Community cards:")
-	fmt.Printf("%v\n", g.CommunityCards)
+	fmt.Println(g.Formatter.Format(g.CommunityCards))
 
 	for i, hand := range g.PlayerHands {
 		fmt.Printf("\n\nPlayer %d:\n", i+1)
-		fmt.Printf("Hole cards: %s, %s\n", hand.HoleCards[0], hand.HoleCards[1])
+		fmt.Printf("Hole cards: %s\n", g.Formatter.Format(hand.HoleCards))
 		fmt.Printf("Best hand: %s\n", hand.HandResult.Name)
-		fmt.Printf("%v\n", hand.HandResult.Cards)
+		fmt.Println(g.Formatter.Format(hand.HandResult.Cards))
 	}
 }
 
@@ -150,8 +370,19 @@ func (g *HoldemGame) PrintGameState() {
  * Runs a simulation of a Texas Hold'em game with the specified number of players.
  */
 func PlayHoldem(numPlayers int) {
-	game := NewGame(numPlayers)
+	playOut(NewGame(numPlayers))
+}
 
+/**
+ * Runs a simulation of a Texas Hold'em game dealt from a deterministic
+ * seed, so the exact same deal can be reproduced across runs and Go
+ * versions - useful for pinning a specific bad-beat scenario in a test.
+ */
+func PlayHoldemSeeded(numPlayers int, seed int64) {
+	playOut(NewGameSeeded(numPlayers, seed))
+}
+
+func playOut(game *HoldemGame) {
 	err := game.DealHoleCards()
 	if err != nil {
 		fmt.Println(err)
@@ -172,8 +403,14 @@ func PlayHoldem(numPlayers int) {
 
 	game.PrintGameState()
 
-	winner := game.DetermineWinner()
-	fmt.Printf("\nWinner: Player %d with %s\n", winner.Player, winner.HandResult.Name)
+	winners := game.DetermineWinner()
+	fmt.Println("\nWinner(s):")
+	// playOut never funds a pot through the betting API, so Payout is
+	// always zero here - print just the winning hand instead of a
+	// misleading "(won 0)".
+	for _, winner := range winners {
+		fmt.Printf("Player %d with %s\n", winner.Player, winner.HandResult.Name)
+	}
 
 	valid, invalidHands := game.Game.VerifyHands()
 	if valid {
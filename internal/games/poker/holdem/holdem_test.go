@@ -0,0 +1,198 @@
+package holdem
+
+import (
+	"testing"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+	"github.com/blixxurd/card-game-go/internal/games/poker/pokerhand"
+)
+
+func TestNewGameSeededDealIsStable(t *testing.T) {
+	first := dealSeeded(t, 42)
+	second := dealSeeded(t, 42)
+
+	if first != second {
+		t.Fatalf("seeded deal is not byte-for-byte stable across runs:\nfirst:  %q\nsecond: %q", first, second)
+	}
+}
+
+func TestNewGameSeededDealDiffersBySeed(t *testing.T) {
+	first := dealSeeded(t, 1)
+	second := dealSeeded(t, 2)
+
+	if first == second {
+		t.Fatalf("deals from different seeds unexpectedly matched: %q", first)
+	}
+}
+
+// TestActAllInRaiseDoesNotSkipRemainingActors pins a bug where an all-in
+// raise closed the betting round one player early: Act computed toAct
+// from activePlayers(), which already excludes the raiser once their
+// Status flips to PlayerAllIn, and then unconditionally decremented
+// toAct again for the raiser's own action.
+func TestActAllInRaiseDoesNotSkipRemainingActors(t *testing.T) {
+	game := NewGame(4)
+	if err := game.StartBettingHand(1000, 10, 20); err != nil {
+		t.Fatalf("StartBettingHand: %v", err)
+	}
+
+	utg := game.ActionOn
+	game.Players[utg].Stack = 100 // short stack: the raise below exactly covers it
+
+	if err := game.Act(utg, ActionRaise, 100); err != nil {
+		t.Fatalf("Act(all-in raise): %v", err)
+	}
+
+	if game.Players[utg].Status != PlayerAllIn {
+		t.Fatalf("expected raiser to be all-in, got status %q", game.Players[utg].Status)
+	}
+	if got := game.toAct; got != 3 {
+		t.Fatalf("expected 3 players still owed a decision after the all-in raise, got %d", got)
+	}
+
+	for i := 0; i < 3; i++ {
+		actor := game.ActionOn
+		if game.Street != StreetPreflop {
+			t.Fatalf("street advanced after only %d of 3 remaining players acted", i)
+		}
+		if err := game.Act(actor, ActionCall, 100); err != nil {
+			t.Fatalf("Act(call) for player %d: %v", actor, err)
+		}
+	}
+
+	if game.Street != StreetFlop {
+		t.Fatalf("expected street to advance to flop once all 3 remaining players acted, got %q", game.Street)
+	}
+}
+
+// TestActSkipsAllInPlayerForNextToAct pins a bug where ActionOn was
+// advanced over contestedPlayers(), which includes all-in players - after
+// a player acted, ActionOn could land back on a player who was already
+// PlayerAllIn and had no legal decision left to make.
+func TestActSkipsAllInPlayerForNextToAct(t *testing.T) {
+	game := NewGame(4)
+	if err := game.StartBettingHand(1000, 10, 20); err != nil {
+		t.Fatalf("StartBettingHand: %v", err)
+	}
+
+	game.ActionOn = 0
+	game.Players[1].Status = PlayerAllIn
+
+	if err := game.Act(0, ActionCall, 0); err != nil {
+		t.Fatalf("Act(call) for player 0: %v", err)
+	}
+
+	if game.ActionOn != 2 {
+		t.Fatalf("expected ActionOn to skip all-in player 1 and land on player 2, got %d", game.ActionOn)
+	}
+}
+
+// TestAdvanceStreetCountsOnlyActivePlayers pins a bug where advanceStreet
+// seeded toAct from contestedPlayers(), which includes all-in players who
+// never act again. With one player all-in and two genuinely active
+// players, toAct was set one too high and never reached 0 from real Act
+// calls, so the betting round never closed once it was dealt.
+func TestAdvanceStreetCountsOnlyActivePlayers(t *testing.T) {
+	game := NewGame(3)
+	if err := game.StartBettingHand(1000, 10, 20); err != nil {
+		t.Fatalf("StartBettingHand: %v", err)
+	}
+
+	shover := game.ActionOn
+	game.Players[shover].Stack = 30 // short stack: the raise below exactly covers it
+
+	if err := game.Act(shover, ActionRaise, 30); err != nil {
+		t.Fatalf("Act(all-in raise): %v", err)
+	}
+	if game.Players[shover].Status != PlayerAllIn {
+		t.Fatalf("expected shover to be all-in, got status %q", game.Players[shover].Status)
+	}
+
+	for i := 0; i < 2; i++ {
+		actor := game.ActionOn
+		if game.Street != StreetPreflop {
+			t.Fatalf("street advanced after only %d of 2 remaining players called", i)
+		}
+		if err := game.Act(actor, ActionCall, 0); err != nil {
+			t.Fatalf("Act(call) for player %d: %v", actor, err)
+		}
+	}
+
+	if game.Street != StreetFlop {
+		t.Fatalf("expected street to advance to flop once both remaining players called, got %q", game.Street)
+	}
+
+	for i := 0; i < 2; i++ {
+		actor := game.ActionOn
+		if game.Street != StreetFlop {
+			t.Fatalf("street advanced after only %d of 2 active players checked", i)
+		}
+		if err := game.Act(actor, ActionCheck, 0); err != nil {
+			t.Fatalf("Act(check) for player %d: %v", actor, err)
+		}
+	}
+
+	if game.Street != StreetTurn {
+		t.Fatalf("expected street to advance to turn once both active players checked the flop, got %q (toAct=%d)", game.Street, game.toAct)
+	}
+}
+
+// TestDetermineWinnerSplitsSidePotAndTies covers the side-pot and
+// multi-way-tie handling DetermineWinner replaced a single-winner,
+// hole-card-tiebreak implementation with: a short-stacked all-in can only
+// win the chips it covered, and players sharing the best HandResult after
+// kicker comparison split a pot evenly.
+func TestDetermineWinnerSplitsSidePotAndTies(t *testing.T) {
+	game := NewGame(3)
+	game.PlayerHands = []PlayerHand{
+		{Player: 1, HandResult: pokerhand.HandResult{Rank: pokerhand.FourOfAKind, Kickers: []int{2, 14}}},
+		{Player: 2, HandResult: pokerhand.HandResult{Rank: pokerhand.FullHouse, Kickers: []int{10, 5}}},
+		{Player: 3, HandResult: pokerhand.HandResult{Rank: pokerhand.FullHouse, Kickers: []int{10, 5}}},
+	}
+
+	// Player 1 shoves for 50 and is covered by the other two, who both put
+	// in 100: a 150-chip main pot every player is eligible for, and a
+	// 100-chip side pot only players 2 and 3 (who covered the extra bet)
+	// can win.
+	game.Pot.Contribute(1, 50)
+	game.Pot.Contribute(2, 100)
+	game.Pot.Contribute(3, 100)
+
+	winners := game.DetermineWinner()
+
+	payouts := make(map[int]int, len(winners))
+	for _, w := range winners {
+		payouts[w.Player] = w.Payout
+	}
+
+	if payouts[1] != 150 {
+		t.Errorf("expected player 1 to win the full 150-chip main pot, got %d", payouts[1])
+	}
+	if payouts[2] != 50 {
+		t.Errorf("expected player 2 to split the 100-chip side pot with player 3, got %d", payouts[2])
+	}
+	if payouts[3] != 50 {
+		t.Errorf("expected player 3 to split the 100-chip side pot with player 2, got %d", payouts[3])
+	}
+}
+
+// dealSeeded deals a full hand from a seeded HoldemGame and renders every
+// hole and community card as a compact string, so two deals can be
+// compared byte-for-byte.
+func dealSeeded(t *testing.T, seed int64) string {
+	t.Helper()
+
+	game := NewGameSeeded(4, seed)
+	if err := game.DealHoleCards(); err != nil {
+		t.Fatalf("DealHoleCards: %v", err)
+	}
+	if err := game.DealCommunityCards(); err != nil {
+		t.Fatalf("DealCommunityCards: %v", err)
+	}
+
+	out := card.Cards(game.CommunityCards).Format(card.StylePlain)
+	for _, hand := range game.Game.Hands {
+		out += "|" + card.Cards(hand).Format(card.StylePlain)
+	}
+	return out
+}
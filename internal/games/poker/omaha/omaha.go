@@ -0,0 +1,315 @@
+package omaha
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame"
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+	"github.com/blixxurd/card-game-go/internal/games/poker/pokerhand"
+)
+
+// MARK: Types
+
+/**
+ * LowHandResult is a qualifying ace-to-five low hand: five cards of
+ * distinct rank, none above 8, with straights and flushes ignored.
+ * Ranks holds the ace-to-five comparison values (ace counts as 1) sorted
+ * highest-first, so the lower Ranks slice wins.
+ */
+type LowHandResult struct {
+	Cards []card.Card
+	Ranks []int
+}
+
+type PlayerHand struct {
+	Player    int
+	HoleCards []card.Card
+	High      pokerhand.HandResult
+	Low       *LowHandResult // nil if this player has no qualifying low, or HiLo is disabled
+}
+
+/**
+ * WinnerResult reports the winner(s) of an Omaha hand. LowWinners is
+ * empty unless the game is HiLo and at least one player qualified.
+ */
+type WinnerResult struct {
+	HighWinners []PlayerHand
+	LowWinners  []PlayerHand
+}
+
+type OmahaGame struct {
+	Game           *cardgame.Game
+	CommunityCards []card.Card
+	PlayerHands    []PlayerHand
+	NumPlayers     int
+	HiLo           bool // when true, also scores an 8-or-better ace-to-five low hand
+}
+
+// MARK: Functions
+
+/**
+ * Creates a new Omaha game with the specified number of players, dealing
+ * four hole cards each. The best hand is the best 5-card combination of
+ * exactly two hole cards and three community cards.
+ */
+func NewGame(numPlayers int) *OmahaGame {
+	return &OmahaGame{
+		Game:           cardgame.NewGameWithEvaluator(numPlayers, pokerhand.OmahaEvaluator{}),
+		CommunityCards: make([]card.Card, 0, 5),
+		PlayerHands:    make([]PlayerHand, numPlayers),
+		NumPlayers:     numPlayers,
+	}
+}
+
+/**
+ * Creates a new Omaha Hi-Lo game, which additionally scores a qualifying
+ * 8-or-better ace-to-five low hand and splits the pot between the best
+ * high hand and the best qualifying low hand.
+ */
+func NewHiLoGame(numPlayers int) *OmahaGame {
+	game := NewGame(numPlayers)
+	game.HiLo = true
+	return game
+}
+
+// MARK: Methods
+
+/**
+ * Deals four cards to each player, as required by Omaha.
+ */
+func (g *OmahaGame) DealHoleCards() error {
+	for i := 0; i < 4; i++ {
+		for handIndex := 0; handIndex < g.NumPlayers; handIndex++ {
+			if err := g.Game.Deal(handIndex); err != nil {
+				return fmt.Errorf("error dealing to hand %d: %v", handIndex, err)
+			}
+		}
+	}
+	return nil
+}
+
+/**
+ * Deals five community cards.
+ */
+func (g *OmahaGame) DealCommunityCards() error {
+	for i := 0; i < 5; i++ {
+		c, err := g.Game.Deck.Draw()
+		if err != nil {
+			return fmt.Errorf("error dealing community card: %v", err)
+		}
+		g.CommunityCards = append(g.CommunityCards, c)
+	}
+	return nil
+}
+
+/**
+ * Evaluates the best high hand for each player, and, for HiLo games, the
+ * best qualifying low hand.
+ */
+func (g *OmahaGame) EvaluateHands() error {
+	evaluator := pokerhand.OmahaEvaluator{}
+
+	for i, hand := range g.Game.Hands {
+		holeCards := []card.Card(hand)
+
+		high, err := evaluator.Evaluate(holeCards, g.CommunityCards)
+		if err != nil {
+			return fmt.Errorf("error evaluating hand for player %d: %v", i+1, err)
+		}
+
+		playerHand := PlayerHand{
+			Player:    i + 1,
+			HoleCards: holeCards,
+			High:      high,
+		}
+
+		if g.HiLo {
+			playerHand.Low = bestLowHand(holeCards, g.CommunityCards)
+		}
+
+		g.PlayerHands[i] = playerHand
+	}
+
+	return nil
+}
+
+/**
+ * Determines the winner(s) of the game. All players sharing the best high
+ * HandResult are co-winners of the high hand; for HiLo games, all players
+ * sharing the best qualifying low hand are co-winners of the low hand.
+ */
+func (g *OmahaGame) DetermineWinner() WinnerResult {
+	var result WinnerResult
+
+	var bestHigh pokerhand.HandResult
+	haveHigh := false
+	for _, ph := range g.PlayerHands {
+		if !haveHigh {
+			result.HighWinners = []PlayerHand{ph}
+			bestHigh = ph.High
+			haveHigh = true
+			continue
+		}
+		switch cmp := pokerhand.CompareHands(ph.High, bestHigh); {
+		case cmp > 0:
+			result.HighWinners = []PlayerHand{ph}
+			bestHigh = ph.High
+		case cmp == 0:
+			result.HighWinners = append(result.HighWinners, ph)
+		}
+	}
+
+	if !g.HiLo {
+		return result
+	}
+
+	var bestLow *LowHandResult
+	for _, ph := range g.PlayerHands {
+		if ph.Low == nil {
+			continue
+		}
+		if bestLow == nil {
+			result.LowWinners = []PlayerHand{ph}
+			bestLow = ph.Low
+			continue
+		}
+		switch cmp := compareLow(ph.Low, bestLow); {
+		case cmp > 0:
+			result.LowWinners = []PlayerHand{ph}
+			bestLow = ph.Low
+		case cmp == 0:
+			result.LowWinners = append(result.LowWinners, ph)
+		}
+	}
+
+	return result
+}
+
+/**
+ * Prints the current state of the game.
+ */
+func (g *OmahaGame) PrintGameState() {
+	fmt.Println("Community cards:")
+	fmt.Printf("%v\n", g.CommunityCards)
+
+	for _, hand := range g.PlayerHands {
+		fmt.Printf("\n\nPlayer %d:\n", hand.Player)
+		fmt.Printf("Hole cards: %v\n", hand.HoleCards)
+		fmt.Printf("Best high hand: %s\n", hand.High.Name)
+		if hand.Low != nil {
+			fmt.Printf("Best low hand: %v\n", hand.Low.Cards)
+		} else if g.HiLo {
+			fmt.Println("No qualifying low hand")
+		}
+	}
+}
+
+/**
+ * Runs a simulation of an Omaha game with the specified number of
+ * players. hiLo selects Omaha Hi-Lo split-pot rules.
+ */
+func PlayOmaha(numPlayers int, hiLo bool) {
+	var game *OmahaGame
+	if hiLo {
+		game = NewHiLoGame(numPlayers)
+	} else {
+		game = NewGame(numPlayers)
+	}
+
+	if err := game.DealHoleCards(); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := game.DealCommunityCards(); err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := game.EvaluateHands(); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	game.PrintGameState()
+
+	winners := game.DetermineWinner()
+	fmt.Println("\nHigh hand winner(s):")
+	for _, ph := range winners.HighWinners {
+		fmt.Printf("Player %d with %s\n", ph.Player, ph.High.Name)
+	}
+	if hiLo {
+		if len(winners.LowWinners) == 0 {
+			fmt.Println("\nNo qualifying low hand - high hand wins the whole pot")
+		} else {
+			fmt.Println("\nLow hand winner(s):")
+			for _, ph := range winners.LowWinners {
+				fmt.Printf("Player %d\n", ph.Player)
+			}
+		}
+	}
+}
+
+/**
+ * bestLowHand returns the best qualifying ace-to-five low hand formed
+ * from exactly two hole cards and three board cards, or nil if no
+ * combination qualifies (every card must be distinct rank and 8 or
+ * lower).
+ */
+func bestLowHand(hole []card.Card, board []card.Card) *LowHandResult {
+	var best *LowHandResult
+
+	for _, hc := range pokerhand.GenerateCombinations(hole, 2) {
+		for _, bc := range pokerhand.GenerateCombinations(board, 3) {
+			combo := make([]card.Card, 0, 5)
+			combo = append(combo, hc...)
+			combo = append(combo, bc...)
+
+			low, ok := formLowHand(combo)
+			if !ok {
+				continue
+			}
+			if best == nil || compareLow(low, best) > 0 {
+				best = low
+			}
+		}
+	}
+
+	return best
+}
+
+func formLowHand(combo []card.Card) (*LowHandResult, bool) {
+	ranks := make([]int, 0, len(combo))
+	seen := make(map[int]bool, len(combo))
+
+	for _, c := range combo {
+		v := aceToFiveValue(c)
+		if v > 8 || seen[v] {
+			return nil, false
+		}
+		seen[v] = true
+		ranks = append(ranks, v)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(ranks)))
+	return &LowHandResult{Cards: combo, Ranks: ranks}, true
+}
+
+func aceToFiveValue(c card.Card) int {
+	if c.Value == 1 {
+		return 1
+	}
+	return c.Value
+}
+
+/**
+ * compareLow returns a positive value if a is a better (lower) hand than
+ * b, zero if tied, and a negative value if b is better.
+ */
+func compareLow(a, b *LowHandResult) int {
+	for i := 0; i < len(a.Ranks) && i < len(b.Ranks); i++ {
+		if a.Ranks[i] != b.Ranks[i] {
+			return b.Ranks[i] - a.Ranks[i]
+		}
+	}
+	return 0
+}
@@ -0,0 +1,50 @@
+package omaha
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+)
+
+// TestBestLowHandFindsNutLow covers the ace-to-five comparison bestLowHand
+// relies on: with a wheel (5-4-3-2-1) available across the hole and board
+// cards, it should be selected as the best qualifying low.
+func TestBestLowHandFindsNutLow(t *testing.T) {
+	hole, err := card.NewCardsFromString("Ah,2d,9s,Tc")
+	if err != nil {
+		t.Fatalf("parsing hole cards: %v", err)
+	}
+	board, err := card.NewCardsFromString("3h,4d,5s,Kc,Qd")
+	if err != nil {
+		t.Fatalf("parsing board: %v", err)
+	}
+
+	low := bestLowHand(hole, board)
+	if low == nil {
+		t.Fatalf("expected a qualifying low hand, got none")
+	}
+
+	want := []int{5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(low.Ranks, want) {
+		t.Fatalf("expected the wheel %v, got %v", want, low.Ranks)
+	}
+}
+
+// TestBestLowHandNoQualifyingLow covers the no-qualifying-low case: only
+// one board card is 8-or-under, so no 2-hole/3-board combination can form
+// five distinct ranks all 8 or below.
+func TestBestLowHandNoQualifyingLow(t *testing.T) {
+	hole, err := card.NewCardsFromString("Ah,2d,9s,Tc")
+	if err != nil {
+		t.Fatalf("parsing hole cards: %v", err)
+	}
+	board, err := card.NewCardsFromString("5h,9d,Th,Jc,Qs")
+	if err != nil {
+		t.Fatalf("parsing board: %v", err)
+	}
+
+	if low := bestLowHand(hole, board); low != nil {
+		t.Fatalf("expected no qualifying low hand, got %v", low.Ranks)
+	}
+}
@@ -0,0 +1,255 @@
+package pokerhand
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+	"github.com/blixxurd/card-game-go/internal/cardgame/deck"
+)
+
+// MARK: Types
+
+/**
+ * EquitySnapshot is a partial result emitted by EquityStream every N
+ * iterations, so a spectator-facing client (e.g. the websocket Hub) can
+ * show a live "win %" as a hand plays out.
+ */
+type EquitySnapshot struct {
+	Iterations int
+	Equity     []float64
+}
+
+// MARK: Functions
+
+/**
+ * Equity estimates each player's equity (win share, with ties split
+ * evenly) by completing the board from the remaining unknown cards.
+ * hole[i] is player i's hole cards; board is whatever community cards are
+ * already known; deadCards are cards known to be out of play (burned or
+ * mucked) that must not be dealt.
+ *
+ * When 2 or fewer community cards remain unknown, every possible
+ * completion is enumerated exactly instead of sampled; otherwise iters
+ * Monte Carlo trials are run in parallel across runtime.NumCPU() workers,
+ * each with its own RNG.
+ */
+func Equity(hole [][]card.Card, board []card.Card, deadCards []card.Card, iters int) ([]float64, error) {
+	remaining := 5 - len(board)
+	if remaining < 0 {
+		return nil, fmt.Errorf("board already has %d cards, more than the 5 allowed", len(board))
+	}
+
+	unknown := unknownCards(hole, board, deadCards)
+
+	if remaining <= 2 {
+		return exactEquity(hole, board, unknown, remaining), nil
+	}
+	return monteCarloEquity(hole, board, unknown, remaining, iters), nil
+}
+
+/**
+ * EquityStream runs the same Monte Carlo simulation as Equity but emits
+ * an EquitySnapshot every snapshotEvery iterations instead of only a
+ * final result, so a spectator UI can push live "win %" updates while a
+ * hand is still in progress. The returned channel closes once iters
+ * trials have run or ctx is cancelled, whichever comes first.
+ */
+func EquityStream(ctx context.Context, hole [][]card.Card, board []card.Card, deadCards []card.Card, iters int, snapshotEvery int) <-chan EquitySnapshot {
+	out := make(chan EquitySnapshot)
+
+	go func() {
+		defer close(out)
+
+		remaining := 5 - len(board)
+		if remaining <= 0 {
+			return
+		}
+		unknown := unknownCards(hole, board, deadCards)
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		wins := make([]float64, len(hole))
+
+		for i := 1; i <= iters; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+
+			tallyWinners(hole, completeBoard(r, board, unknown, remaining), wins)
+
+			if i%snapshotEvery != 0 && i != iters {
+				continue
+			}
+
+			snapshot := make([]float64, len(wins))
+			for j, w := range wins {
+				snapshot[j] = w / float64(i)
+			}
+			select {
+			case out <- EquitySnapshot{Iterations: i, Equity: snapshot}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func monteCarloEquity(hole [][]card.Card, board []card.Card, unknown []card.Card, remaining int, iters int) []float64 {
+	workers := runtime.NumCPU()
+	if workers > iters {
+		workers = iters
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	perWorker := iters / workers
+	extra := iters % workers
+
+	wins := make([]float64, len(hole))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		n := perWorker
+		if w < extra {
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(n int, seed int64) {
+			defer wg.Done()
+
+			r := rand.New(rand.NewSource(seed))
+			localWins := make([]float64, len(hole))
+
+			for i := 0; i < n; i++ {
+				tallyWinners(hole, completeBoard(r, board, unknown, remaining), localWins)
+			}
+
+			mu.Lock()
+			for i := range wins {
+				wins[i] += localWins[i]
+			}
+			mu.Unlock()
+		}(n, time.Now().UnixNano()+int64(w))
+	}
+
+	wg.Wait()
+
+	equity := make([]float64, len(hole))
+	for i, w := range wins {
+		equity[i] = w / float64(iters)
+	}
+	return equity
+}
+
+func exactEquity(hole [][]card.Card, board []card.Card, unknown []card.Card, remaining int) []float64 {
+	wins := make([]float64, len(hole))
+
+	if remaining == 0 {
+		tallyWinners(hole, board, wins)
+		return wins
+	}
+
+	combos := GenerateCombinations(unknown, remaining)
+	for _, combo := range combos {
+		completed := make([]card.Card, 0, len(board)+remaining)
+		completed = append(completed, board...)
+		completed = append(completed, combo...)
+		tallyWinners(hole, completed, wins)
+	}
+
+	for i := range wins {
+		wins[i] /= float64(len(combos))
+	}
+	return wins
+}
+
+/**
+ * tallyWinners evaluates every player's best hand against the given board
+ * and adds 1 (split evenly among ties) to the winner(s)' running total in
+ * wins.
+ */
+func tallyWinners(hole [][]card.Card, board []card.Card, wins []float64) {
+	scores := make([]HandScore, len(hole))
+	var bestScore HandScore
+
+	for i, h := range hole {
+		all := make([]card.Card, 0, len(h)+len(board))
+		all = append(all, h...)
+		all = append(all, board...)
+
+		result, err := EvaluateBestHand(all)
+		if err != nil {
+			continue
+		}
+		scores[i] = result.Score()
+		if scores[i] > bestScore {
+			bestScore = scores[i]
+		}
+	}
+
+	winners := 0
+	for _, s := range scores {
+		if s == bestScore {
+			winners++
+		}
+	}
+	if winners == 0 {
+		return
+	}
+
+	share := 1.0 / float64(winners)
+	for i, s := range scores {
+		if s == bestScore {
+			wins[i] += share
+		}
+	}
+}
+
+func completeBoard(r *rand.Rand, board []card.Card, unknown []card.Card, remaining int) []card.Card {
+	shuffled := make([]card.Card, len(unknown))
+	copy(shuffled, unknown)
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	completed := make([]card.Card, len(board), len(board)+remaining)
+	copy(completed, board)
+	return append(completed, shuffled[:remaining]...)
+}
+
+func unknownCards(hole [][]card.Card, board []card.Card, dead []card.Card) []card.Card {
+	used := make(map[card.Card]bool)
+	for _, h := range hole {
+		for _, c := range h {
+			used[c] = true
+		}
+	}
+	for _, c := range board {
+		used[c] = true
+	}
+	for _, c := range dead {
+		used[c] = true
+	}
+
+	full := deck.NewDeck()
+	unknown := make([]card.Card, 0, len(full)-len(used))
+	for _, c := range full {
+		if !used[c] {
+			unknown = append(unknown, c)
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,197 @@
+package pokerhand
+
+import (
+	"fmt"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+)
+
+// MARK: Types
+
+/**
+ * Evaluator determines the best HandResult a player can make from their
+ * hole cards and the shared board. Different poker variants combine hole
+ * and board cards under different rules, so each variant gets its own
+ * implementation, letting Game share the same deck/hand plumbing across
+ * Hold'em, Omaha, stud, and wildcard games.
+ */
+type Evaluator interface {
+	Evaluate(hole []card.Card, board []card.Card) (HandResult, error)
+}
+
+/**
+ * HoldemEvaluator evaluates Texas Hold'em hands, where any five of the
+ * combined hole and board cards may be used.
+ */
+type HoldemEvaluator struct{}
+
+/**
+ * SevenCardStudEvaluator evaluates seven-card stud hands, where the best
+ * five of the seven dealt cards are used. Stud deals all of a player's
+ * cards as their hole cards, so board is typically empty. It picks the
+ * best hand via BestHandRecursive's divide-and-conquer removal instead of
+ * EvaluateBestHand's eager C(n,5) enumeration.
+ */
+type SevenCardStudEvaluator struct{}
+
+/**
+ * OmahaEvaluator evaluates Omaha hands, where exactly two hole cards must
+ * be combined with exactly three board cards.
+ */
+type OmahaEvaluator struct{}
+
+/**
+ * WildcardEvaluator wraps another Evaluator and substitutes any joker
+ * found in the hole or board cards for whichever standard card yields the
+ * best hand, as in Five Crowns-style wildcard games. Base defaults to
+ * HoldemEvaluator when left unset.
+ */
+type WildcardEvaluator struct {
+	Base Evaluator
+}
+
+// MARK: Methods
+
+func (HoldemEvaluator) Evaluate(hole []card.Card, board []card.Card) (HandResult, error) {
+	return EvaluateBestHand(combineCards(hole, board))
+}
+
+func (SevenCardStudEvaluator) Evaluate(hole []card.Card, board []card.Card) (HandResult, error) {
+	return BestHandRecursive(combineCards(hole, board))
+}
+
+func (OmahaEvaluator) Evaluate(hole []card.Card, board []card.Card) (HandResult, error) {
+	if len(hole) != 4 {
+		return HandResult{}, fmt.Errorf("omaha requires exactly 4 hole cards, got %d", len(hole))
+	}
+	if len(board) < 3 {
+		return HandResult{}, fmt.Errorf("omaha requires at least 3 board cards, got %d", len(board))
+	}
+
+	holeCombos := GenerateCombinations(hole, 2)
+	boardCombos := GenerateCombinations(board, 3)
+
+	var best HandResult
+	haveBest := false
+
+	for _, hc := range holeCombos {
+		for _, bc := range boardCombos {
+			result := evaluateHand(combineCards(hc, bc))
+			if !haveBest || CompareHands(result, best) > 0 {
+				best = result
+				haveBest = true
+			}
+		}
+	}
+
+	if !haveBest {
+		return HandResult{}, fmt.Errorf("no valid omaha combination found")
+	}
+	return best, nil
+}
+
+func (w WildcardEvaluator) Evaluate(hole []card.Card, board []card.Card) (HandResult, error) {
+	base := w.Base
+	if base == nil {
+		base = HoldemEvaluator{}
+	}
+	return evaluateResolvingJokers(base, hole, board)
+}
+
+/**
+ * Recursively substitutes the first joker found in hole or board for every
+ * standard card, keeping whichever substitution scores highest, until no
+ * jokers remain and the wrapped Evaluator can score the hand directly.
+ */
+func evaluateResolvingJokers(base Evaluator, hole, board []card.Card) (HandResult, error) {
+	if idx := jokerIndex(hole); idx != -1 {
+		return bestJokerSubstitution(base, hole, board, idx, true)
+	}
+	if idx := jokerIndex(board); idx != -1 {
+		return bestJokerSubstitution(base, hole, board, idx, false)
+	}
+	return base.Evaluate(hole, board)
+}
+
+func bestJokerSubstitution(base Evaluator, hole, board []card.Card, idx int, inHole bool) (HandResult, error) {
+	var best HandResult
+	haveBest := false
+
+	for suit := card.Spades; suit <= card.Clubs; suit++ {
+		for value := 1; value <= 13; value++ {
+			substitute := card.Card{Suit: suit, Value: value}
+			if cardInUse(hole, board, idx, inHole, substitute) {
+				continue // already held elsewhere - can't resolve the joker into a duplicate
+			}
+
+			newHole, newBoard := hole, board
+			if inHole {
+				newHole = replaceAt(hole, idx, substitute)
+			} else {
+				newBoard = replaceAt(board, idx, substitute)
+			}
+
+			result, err := evaluateResolvingJokers(base, newHole, newBoard)
+			if err != nil {
+				continue
+			}
+			if !haveBest || CompareHands(result, best) > 0 {
+				best = result
+				haveBest = true
+			}
+		}
+	}
+
+	if !haveBest {
+		return HandResult{}, fmt.Errorf("no valid substitution found for joker")
+	}
+	return best, nil
+}
+
+// cardInUse reports whether substitute already appears in hole or board,
+// ignoring the joker position (idx, in hole if inHole else board) that is
+// about to be replaced. evaluateHand has no notion of a physical deck, so
+// nothing else stops a joker from being "resolved" into a second copy of
+// a card already in the hand.
+func cardInUse(hole, board []card.Card, idx int, inHole bool, substitute card.Card) bool {
+	for i, c := range hole {
+		if inHole && i == idx {
+			continue
+		}
+		if c == substitute {
+			return true
+		}
+	}
+	for i, c := range board {
+		if !inHole && i == idx {
+			continue
+		}
+		if c == substitute {
+			return true
+		}
+	}
+	return false
+}
+
+func jokerIndex(cards []card.Card) int {
+	for i, c := range cards {
+		if c.IsJoker() {
+			return i
+		}
+	}
+	return -1
+}
+
+func replaceAt(cards []card.Card, idx int, c card.Card) []card.Card {
+	out := make([]card.Card, len(cards))
+	copy(out, cards)
+	out[idx] = c
+	return out
+}
+
+func combineCards(hole, board []card.Card) []card.Card {
+	all := make([]card.Card, 0, len(hole)+len(board))
+	all = append(all, hole...)
+	all = append(all, board...)
+	return all
+}
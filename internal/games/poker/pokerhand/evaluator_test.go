@@ -0,0 +1,36 @@
+package pokerhand
+
+import (
+	"testing"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+)
+
+// TestWildcardEvaluatorDoesNotDuplicateCards pins down a bug where a joker
+// could be resolved into a card already held elsewhere in the hand -
+// bestJokerSubstitution has no notion of a physical deck, so nothing else
+// stopped it from "dealing" a second copy of a card already in play.
+func TestWildcardEvaluatorDoesNotDuplicateCards(t *testing.T) {
+	hole := []card.Card{card.NewJoker(), {Suit: card.Spades, Value: 1}} // joker, As
+	board, err := card.NewCardsFromString("Ah,Ad,Ks,Qh")
+	if err != nil {
+		t.Fatalf("parsing board: %v", err)
+	}
+
+	result, err := (WildcardEvaluator{}).Evaluate(hole, board)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+
+	if result.Rank != FourOfAKind {
+		t.Fatalf("expected the joker to resolve into the last ace for quads, got rank %v (%s)", result.Rank, result.Name)
+	}
+
+	seen := make(map[card.Card]int)
+	for _, c := range result.Cards {
+		seen[c]++
+		if seen[c] > 1 {
+			t.Fatalf("hand contains a duplicate card %s: %v", c.Short(), result.Cards)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package pokerhand
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+)
+
+/**
+ * ParseHandString parses a canonical "hole|board" hand string, e.g.
+ * "AsKs|QhJhTh", into separate hole and board card slices. The board half
+ * may be omitted (e.g. "AsKs") or left empty (e.g. "AsKs|") when only hole
+ * cards are known yet.
+ */
+func ParseHandString(s string) (hole []card.Card, board []card.Card, err error) {
+	parts := strings.SplitN(s, "|", 2)
+
+	hole, err = parseCardRun(parts[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid hole cards: %v", err)
+	}
+
+	if len(parts) == 2 && parts[1] != "" {
+		board, err = parseCardRun(parts[1])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid board cards: %v", err)
+		}
+	}
+
+	return hole, board, nil
+}
+
+/**
+ * FormatHandString renders hole and board cards back into the canonical
+ * "hole|board" form consumed by ParseHandString, so hand histories and
+ * test fixtures can round-trip through a single string.
+ */
+func FormatHandString(hole []card.Card, board []card.Card) string {
+	return formatCardRun(hole) + "|" + formatCardRun(board)
+}
+
+func parseCardRun(s string) ([]card.Card, error) {
+	return card.NewCardsFromShortString(s)
+}
+
+func formatCardRun(cards []card.Card) string {
+	var b strings.Builder
+	for _, c := range cards {
+		b.WriteString(c.Short())
+	}
+	return b.String()
+}
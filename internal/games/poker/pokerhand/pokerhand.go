@@ -2,7 +2,6 @@ package pokerhand
 
 import (
 	"fmt"
-	"log"
 	"sort"
 
 	"github.com/blixxurd/card-game-go/internal/cardgame/card"
@@ -24,10 +23,10 @@ const (
 )
 
 type HandResult struct {
-	Rank      HandRank
-	Name      string
-	Cards     []card.Card
-	HighCards []int
+	Rank    HandRank
+	Name    string
+	Cards   []card.Card
+	Kickers []int // tie-break values, most significant first, used to fully resolve hands of equal Rank
 }
 
 /**
@@ -43,10 +42,10 @@ func EvaluateBestHand(cards []card.Card) (HandResult, error) {
 		return HandResult{}, fmt.Errorf("not enough cards to evaluate hand")
 	}
 
-	combinations := generateCombinations(cards, 5)
+	combinations := GenerateCombinations(cards, 5)
 
 	// Assume all hands have a high card as the best hand to start
-	var bestHand HandResult = HandResult{Rank: HighCard, Name: "High Card", Cards: combinations[0], HighCards: getHighCards(combinations[0], 5)}
+	var bestHand HandResult = HandResult{Rank: HighCard, Name: "High Card", Cards: combinations[0], Kickers: getHighCards(combinations[0], 5)}
 
 	for _, combo := range combinations {
 		result := evaluateHand(combo)
@@ -57,8 +56,6 @@ func EvaluateBestHand(cards []card.Card) (HandResult, error) {
 		}
 	}
 
-	log.Printf("Best hand: %v", bestHand)
-
 	return bestHand, nil
 }
 
@@ -82,44 +79,44 @@ func evaluateHand(hand []card.Card) HandResult {
 
 	if isFlush && isStraight {
 		if highCard == 14 { // Ace high
-			return HandResult{Rank: RoyalFlush, Name: "Royal Flush", Cards: sortedHand, HighCards: []int{14}}
+			return HandResult{Rank: RoyalFlush, Name: "Royal Flush", Cards: sortedHand, Kickers: []int{14}}
 		}
-		return HandResult{Rank: StraightFlush, Name: "Straight Flush", Cards: sortedHand, HighCards: []int{highCard}}
+		return HandResult{Rank: StraightFlush, Name: "Straight Flush", Cards: sortedHand, Kickers: []int{highCard}}
 	}
 
 	if isFlush {
-		return HandResult{Rank: Flush, Name: "Flush", Cards: sortedHand, HighCards: getHighCards(sortedHand, 5)}
+		return HandResult{Rank: Flush, Name: "Flush", Cards: sortedHand, Kickers: getHighCards(sortedHand, 5)}
 	}
 
 	if isStraight {
-		return HandResult{Rank: Straight, Name: "Straight", Cards: sortedHand, HighCards: []int{highCard}}
+		return HandResult{Rank: Straight, Name: "Straight", Cards: sortedHand, Kickers: []int{highCard}}
 	}
 
 	valueCounts := countValues(sortedHand)
 
 	if hasFourOfAKind(valueCounts) {
-		return HandResult{Rank: FourOfAKind, Name: "Four of a Kind", Cards: sortedHand, HighCards: getHighCards(sortedHand, 2)}
+		return HandResult{Rank: FourOfAKind, Name: "Four of a Kind", Cards: sortedHand, Kickers: groupedKickers(valueCounts)}
 	}
 
 	if hasFullHouse(valueCounts) {
-		return HandResult{Rank: FullHouse, Name: "Full House", Cards: sortedHand, HighCards: getHighCards(sortedHand, 2)}
+		return HandResult{Rank: FullHouse, Name: "Full House", Cards: sortedHand, Kickers: groupedKickers(valueCounts)}
 	}
 
 	if hasThreeOfAKind(valueCounts) {
-		return HandResult{Rank: ThreeOfAKind, Name: "Three of a Kind", Cards: sortedHand, HighCards: getHighCards(sortedHand, 3)}
+		return HandResult{Rank: ThreeOfAKind, Name: "Three of a Kind", Cards: sortedHand, Kickers: groupedKickers(valueCounts)}
 	}
 
 	pairCount := countPairs(valueCounts)
 	if pairCount == 2 {
-		return HandResult{Rank: TwoPair, Name: "Two Pair", Cards: sortedHand, HighCards: getHighCards(sortedHand, 3)}
+		return HandResult{Rank: TwoPair, Name: "Two Pair", Cards: sortedHand, Kickers: groupedKickers(valueCounts)}
 	}
 
 	if pairCount == 1 {
-		return HandResult{Rank: Pair, Name: "Pair", Cards: sortedHand, HighCards: getHighCards(sortedHand, 4)}
+		return HandResult{Rank: Pair, Name: "Pair", Cards: sortedHand, Kickers: groupedKickers(valueCounts)}
 	}
 
 	highCard = getComparisonValue(sortedHand[0])
-	return HandResult{Rank: HighCard, Name: fmt.Sprintf("High Card %s", cardValueToString(highCard)), Cards: sortedHand, HighCards: getHighCards(sortedHand, 5)}
+	return HandResult{Rank: HighCard, Name: fmt.Sprintf("High Card %s", cardValueToString(highCard)), Cards: sortedHand, Kickers: getHighCards(sortedHand, 5)}
 }
 
 /**
@@ -253,6 +250,28 @@ func getHighCards(hand []card.Card, count int) []int {
 	return highCards
 }
 
+/**
+ * Returns every distinct value in a hand ordered for kicker comparison:
+ * by how many cards share that value (so a quad/trip/pair outranks a
+ * bare kicker of any value), then by value itself. Used instead of
+ * getHighCards for any hand with a paired/trip/quad group, since slicing
+ * the plain value-sorted hand doesn't put the grouped rank ahead of a
+ * kicker that happens to sort higher by raw value.
+ */
+func groupedKickers(counts map[int]int) []int {
+	values := make([]int, 0, len(counts))
+	for v := range counts {
+		values = append(values, v)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if counts[values[i]] != counts[values[j]] {
+			return counts[values[i]] > counts[values[j]]
+		}
+		return values[i] > values[j]
+	})
+	return values
+}
+
 /**
  * Converts a card value to a string representation.
  */
@@ -275,7 +294,10 @@ func cardValueToString(value int) string {
  * Compares two HandResult structs and returns an integer value indicating
  * the result of the comparison. The function returns a negative value if
  * hand1 is less than hand2, a positive value if hand1 is greater than hand2,
- * and zero if the two hands are equal.
+ * and zero if the two hands are equal. Ties are resolved entirely by rank
+ * and Kickers - callers must not fall back to comparing hole cards, since a
+ * zero result here means the hands are genuinely identical for payout
+ * purposes and should split the pot.
  */
 func CompareHands(hand1, hand2 HandResult) int {
 	// Compare ranks
@@ -283,10 +305,10 @@ func CompareHands(hand1, hand2 HandResult) int {
 		return int(hand1.Rank) - int(hand2.Rank)
 	}
 
-	// Compare high cards
-	for i := 0; i < len(hand1.HighCards) && i < len(hand2.HighCards); i++ {
-		if hand1.HighCards[i] != hand2.HighCards[i] {
-			return hand1.HighCards[i] - hand2.HighCards[i]
+	// Compare kickers
+	for i := 0; i < len(hand1.Kickers) && i < len(hand2.Kickers); i++ {
+		if hand1.Kickers[i] != hand2.Kickers[i] {
+			return hand1.Kickers[i] - hand2.Kickers[i]
 		}
 	}
 
@@ -294,12 +316,12 @@ func CompareHands(hand1, hand2 HandResult) int {
 }
 
 /**
- * Generates all possible combinations of k cards from a slice of cards.
- * Uses a recursive backtracking algorithm to generate the combinations.
- * This method is used to generate all possible 5-card hands from a set of
- * cards.
+ * GenerateCombinations returns every possible combination of k cards from
+ * cards, via recursive backtracking. Exported so other poker packages
+ * (Omaha's hole/board splitting, Monte Carlo equity's exact enumeration)
+ * can share this instead of keeping their own copies.
  */
-func generateCombinations(cards []card.Card, k int) [][]card.Card {
+func GenerateCombinations(cards []card.Card, k int) [][]card.Card {
 	var combos [][]card.Card
 	var combo []card.Card
 	var generate func(int, int)
@@ -0,0 +1,66 @@
+package pokerhand
+
+import (
+	"testing"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+)
+
+// TestCompareHandsKickerOrdering pins down the bug where Kickers were
+// built by slicing the value-sorted hand instead of ranking grouped
+// pair/trip/quad values ahead of plain kickers - a bare kicker that
+// happened to sort higher by raw value than the grouped rank would
+// otherwise win a tie it should lose.
+func TestCompareHandsKickerOrdering(t *testing.T) {
+	tests := []struct {
+		name    string
+		winner  string
+		loser   string
+		winRank HandRank
+	}{
+		{
+			name:    "full house ranks by trip value first, not by the pair's value",
+			winner:  "6s,6h,6d,2c,2h",
+			loser:   "5s,5h,5d,Kc,Kh",
+			winRank: FullHouse,
+		},
+		{
+			name:    "two pair ranks by the second pair, not by an unrelated kicker",
+			winner:  "Ad,Ac,9d,9c,Th",
+			loser:   "As,Ah,7d,7c,Kh",
+			winRank: TwoPair,
+		},
+		{
+			name:    "four of a kind ranks by the quad value, not the kicker",
+			winner:  "Jh,Js,Jd,Jc,2d",
+			loser:   "2s,2h,2d,2c,Ah",
+			winRank: FourOfAKind,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winnerCards, err := card.NewCardsFromString(tt.winner)
+			if err != nil {
+				t.Fatalf("parsing winner %q: %v", tt.winner, err)
+			}
+			loserCards, err := card.NewCardsFromString(tt.loser)
+			if err != nil {
+				t.Fatalf("parsing loser %q: %v", tt.loser, err)
+			}
+
+			winner := evaluateHand(winnerCards)
+			loser := evaluateHand(loserCards)
+
+			if winner.Rank != tt.winRank {
+				t.Fatalf("expected winner rank %v, got %v", tt.winRank, winner.Rank)
+			}
+			if loser.Rank != tt.winRank {
+				t.Fatalf("expected loser rank %v, got %v", tt.winRank, loser.Rank)
+			}
+			if cmp := CompareHands(winner, loser); cmp <= 0 {
+				t.Fatalf("expected %q to beat %q, got CompareHands=%d", tt.winner, tt.loser, cmp)
+			}
+		})
+	}
+}
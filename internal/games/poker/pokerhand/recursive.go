@@ -0,0 +1,144 @@
+package pokerhand
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+)
+
+// MARK: Types
+
+/**
+ * HandScore packs a HandResult's rank and kicker chain into a single
+ * uint32 so two hands can be compared with one integer comparison
+ * instead of walking Kickers slices. The HandRank occupies the top
+ * byte; each of up to six kickers occupies a 4-bit nibble below it, most
+ * significant first.
+ */
+type HandScore uint32
+
+const (
+	handScoreRankShift  = 24
+	handScoreKickerBits = 4
+	handScoreMaxKickers = 6
+)
+
+// MARK: Variables
+
+/**
+ * ErrDuplicateCard is returned when the input to BestHandRecursive
+ * contains the same card more than once, e.g. a caller passed overlapping
+ * hole and board cards without de-duplicating them first.
+ */
+var ErrDuplicateCard = errors.New("duplicate card in input")
+
+// MARK: Functions
+
+/**
+ * BestHandRecursive finds the best 5-card hand in an arbitrary-size set
+ * of cards by recursively removing one card at a time until exactly 5
+ * remain, evaluating each leaf, and keeping the max by HandScore as the
+ * recursion unwinds. This is a divide-and-conquer alternative to
+ * EvaluateBestHand's eager C(n,5) enumeration. Overlapping 5-card subsets
+ * reached via different removal orders are memoized, so each distinct
+ * subset is only scored once.
+ */
+func BestHandRecursive(cards []card.Card) (HandResult, error) {
+	if len(cards) < 5 {
+		return HandResult{}, fmt.Errorf("not enough cards to evaluate hand")
+	}
+	if err := checkDuplicateCards(cards); err != nil {
+		return HandResult{}, err
+	}
+	memo := make(map[string]HandResult)
+	return bestHandRecursive(cards, memo)
+}
+
+func bestHandRecursive(cards []card.Card, memo map[string]HandResult) (HandResult, error) {
+	key := subsetKey(cards)
+	if cached, ok := memo[key]; ok {
+		return cached, nil
+	}
+
+	if len(cards) == 5 {
+		best := evaluateHand(cards)
+		memo[key] = best
+		return best, nil
+	}
+
+	var best HandResult
+	haveBest := false
+
+	for i := range cards {
+		remaining := make([]card.Card, 0, len(cards)-1)
+		remaining = append(remaining, cards[:i]...)
+		remaining = append(remaining, cards[i+1:]...)
+
+		result, err := bestHandRecursive(remaining, memo)
+		if err != nil {
+			return HandResult{}, err
+		}
+		if !haveBest || result.Score() > best.Score() {
+			best = result
+			haveBest = true
+		}
+	}
+
+	memo[key] = best
+	return best, nil
+}
+
+func checkDuplicateCards(cards []card.Card) error {
+	seen := make(map[card.Card]bool, len(cards))
+	for _, c := range cards {
+		if seen[c] {
+			return ErrDuplicateCard
+		}
+		seen[c] = true
+	}
+	return nil
+}
+
+// subsetKey returns a canonical, order-independent key for a set of
+// cards, so bestHandRecursive can recognize the same subset reached
+// through two different removal orders as one memo entry.
+func subsetKey(cards []card.Card) string {
+	sorted := make([]card.Card, len(cards))
+	copy(sorted, cards)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Suit != sorted[j].Suit {
+			return sorted[i].Suit < sorted[j].Suit
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	var key strings.Builder
+	for _, c := range sorted {
+		fmt.Fprintf(&key, "%d:%d,", c.Suit, c.Value)
+	}
+	return key.String()
+}
+
+// MARK: Methods
+
+/**
+ * Score packs the HandResult's rank and kickers into a single HandScore
+ * so callers can compare hands with one integer comparison.
+ */
+func (h HandResult) Score() HandScore {
+	return newHandScore(h.Rank, h.Kickers)
+}
+
+func newHandScore(rank HandRank, kickers []int) HandScore {
+	score := uint32(rank) << handScoreRankShift
+
+	for i := 0; i < len(kickers) && i < handScoreMaxKickers; i++ {
+		shift := uint(handScoreKickerBits * (handScoreMaxKickers - 1 - i))
+		score |= (uint32(kickers[i]) & 0xF) << shift
+	}
+
+	return HandScore(score)
+}
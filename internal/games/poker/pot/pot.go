@@ -0,0 +1,80 @@
+/**
+ * Package pot implements side-pot splitting for poker chip payouts. The
+ * algorithm is shared across variants and delivery mechanisms - both
+ * holdem.Pot and the gameserver's HoldemTable delegate to it rather than
+ * keeping their own copies, since one all-in-for-less-than-a-full-bet
+ * rule applies regardless of whether the player is identified by number
+ * or by seat index.
+ */
+package pot
+
+import "sort"
+
+// MARK: Types
+
+/**
+ * SidePot is a pot eligible to be won only by the players listed in
+ * EligiblePlayers - the mechanism that lets an all-in player compete for
+ * the chips they covered while later, bigger bets form a separate pot
+ * among the remaining players.
+ */
+type SidePot struct {
+	Amount          int
+	EligiblePlayers []int
+}
+
+// MARK: Functions
+
+/**
+ * CalculateSidePots splits total contributions into one or more SidePots,
+ * so that a player who went all-in for less than another player's bet can
+ * only win up to the amount they contributed, with everything above that
+ * forming side pots among the players still able to match it.
+ *
+ * contributions maps a player identifier (a player number or a seat
+ * index, depending on the caller) to the total chips that player put in
+ * this hand. folded marks players that contributed but are no longer
+ * eligible to win any pot.
+ */
+func CalculateSidePots(contributions map[int]int, folded map[int]bool) []SidePot {
+	players := make([]int, 0, len(contributions))
+	for player, amount := range contributions {
+		if amount > 0 {
+			players = append(players, player)
+		}
+	}
+	sort.Slice(players, func(i, j int) bool {
+		return contributions[players[i]] < contributions[players[j]]
+	})
+
+	var pots []SidePot
+	previousLevel := 0
+
+	// players is sorted ascending by contribution, so at index i every
+	// player from i onward contributed at least contributions[players[i]]
+	// - that whole remaining slice is "still in" for the pot formed at
+	// this level, whether or not they later folded.
+	for i, player := range players {
+		level := contributions[player]
+		if level == previousLevel {
+			continue
+		}
+
+		remaining := players[i:]
+		amount := (level - previousLevel) * len(remaining)
+
+		var eligible []int
+		for _, p := range remaining {
+			if !folded[p] {
+				eligible = append(eligible, p)
+			}
+		}
+
+		if amount > 0 && len(eligible) > 0 {
+			pots = append(pots, SidePot{Amount: amount, EligiblePlayers: eligible})
+		}
+		previousLevel = level
+	}
+
+	return pots
+}
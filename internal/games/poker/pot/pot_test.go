@@ -0,0 +1,63 @@
+package pot
+
+import "testing"
+
+func TestCalculateSidePotsSplitsShortStackedAllIn(t *testing.T) {
+	contributions := map[int]int{1: 50, 2: 100, 3: 100}
+	folded := map[int]bool{}
+
+	pots := CalculateSidePots(contributions, folded)
+
+	if len(pots) != 2 {
+		t.Fatalf("expected a main pot and one side pot, got %d pots: %+v", len(pots), pots)
+	}
+
+	main := pots[0]
+	if main.Amount != 150 {
+		t.Errorf("expected main pot of 150, got %d", main.Amount)
+	}
+	if !sameSet(main.EligiblePlayers, []int{1, 2, 3}) {
+		t.Errorf("expected main pot eligible players [1 2 3], got %v", main.EligiblePlayers)
+	}
+
+	side := pots[1]
+	if side.Amount != 100 {
+		t.Errorf("expected side pot of 100, got %d", side.Amount)
+	}
+	if !sameSet(side.EligiblePlayers, []int{2, 3}) {
+		t.Errorf("expected side pot eligible players [2 3], got %v", side.EligiblePlayers)
+	}
+}
+
+func TestCalculateSidePotsExcludesFoldedPlayers(t *testing.T) {
+	contributions := map[int]int{1: 100, 2: 100, 3: 100}
+	folded := map[int]bool{2: true}
+
+	pots := CalculateSidePots(contributions, folded)
+
+	if len(pots) != 1 {
+		t.Fatalf("expected a single pot, got %d pots: %+v", len(pots), pots)
+	}
+	if pots[0].Amount != 300 {
+		t.Errorf("expected pot of 300 (all contributions still count), got %d", pots[0].Amount)
+	}
+	if !sameSet(pots[0].EligiblePlayers, []int{1, 3}) {
+		t.Errorf("expected folded player 2 excluded from eligibility, got %v", pots[0].EligiblePlayers)
+	}
+}
+
+func sameSet(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[int]bool, len(a))
+	for _, v := range a {
+		seen[v] = true
+	}
+	for _, v := range b {
+		if !seen[v] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,814 @@
+package gameserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/blixxurd/card-game-go/internal/cardgame/card"
+	"github.com/blixxurd/card-game-go/internal/cardgame/deck"
+	"github.com/blixxurd/card-game-go/internal/games/poker/pokerhand"
+	"github.com/blixxurd/card-game-go/internal/net"
+)
+
+// MARK: Types
+
+/**
+ * Street identifies which betting round a HoldemTable is in.
+ */
+type Street string
+
+const (
+	StreetPreflop  Street = "preflop"
+	StreetFlop     Street = "flop"
+	StreetTurn     Street = "turn"
+	StreetRiver    Street = "river"
+	StreetShowdown Street = "showdown"
+)
+
+/**
+ * SeatStatus is the state of a single seat within the current hand.
+ */
+type SeatStatus string
+
+const (
+	SeatEmpty  SeatStatus = "empty"
+	SeatActive SeatStatus = "active"
+	SeatFolded SeatStatus = "folded"
+	SeatAllIn  SeatStatus = "all_in"
+)
+
+type action string
+
+const (
+	actionFold  action = "fold"
+	actionCheck action = "check"
+	actionCall  action = "call"
+	actionBet   action = "bet"
+	actionRaise action = "raise"
+	actionAllIn action = "all_in"
+)
+
+/**
+ * Seat is one position at a HoldemTable. During a hand, hole cards are
+ * sent over the wire only to this seat's own Client; at showdown, the
+ * cards of every seat still in the hand are broadcast to all clients.
+ */
+type Seat struct {
+	Client      *websocket.Client
+	Stack       int
+	Bet         int // chips committed on the current street
+	Contributed int // chips committed across the whole hand, for side pots
+	HoleCards   []card.Card
+	Status      SeatStatus
+}
+
+/**
+ * HoldemTable maintains the full state of one multiplayer Texas Hold'em
+ * table on top of a websocket Hub: seats, blinds, the betting street, the
+ * pot, and whose action it is. It consumes typed inbound messages and
+ * emits per-client and broadcast outbound events.
+ */
+type HoldemTable struct {
+	mutex sync.Mutex
+
+	ID    string
+	Hub   *websocket.Hub
+	Seats []*Seat
+
+	ButtonSeat int
+	SmallBlind int
+	BigBlind   int
+
+	Deck           deck.Deck
+	CommunityCards []card.Card
+	Street         Street
+	CurrentBet     int
+	MinRaise       int
+	ActionOn       int
+	toAct          int // seats still needing to act before the street closes
+
+	Evaluator pokerhand.Evaluator
+
+	ActionTimeout time.Duration
+}
+
+// MARK: Functions
+
+/**
+ * Creates a new HoldemTable with the given number of seats and blind
+ * levels, and attaches it to hub as the TableHandler every inbound
+ * Message from hub's clients is routed to - hub.SetTable must not
+ * already have been called for a different table.
+ */
+func NewHoldemTable(id string, hub *websocket.Hub, seatCount, smallBlind, bigBlind int) *HoldemTable {
+	seats := make([]*Seat, seatCount)
+	for i := range seats {
+		seats[i] = &Seat{Status: SeatEmpty}
+	}
+
+	table := &HoldemTable{
+		ID:            id,
+		Hub:           hub,
+		Seats:         seats,
+		SmallBlind:    smallBlind,
+		BigBlind:      bigBlind,
+		Street:        StreetPreflop,
+		Evaluator:     pokerhand.HoldemEvaluator{},
+		ActionTimeout: 30 * time.Second,
+	}
+	hub.SetTable(table)
+	return table
+}
+
+// MARK: Methods
+
+/**
+ * HandleMessage routes one inbound Message from client to the
+ * appropriate handler and broadcasts/sends whatever events result.
+ */
+func (t *HoldemTable) HandleMessage(client *websocket.Client, msg websocket.Message) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	switch msg.Type {
+	case MsgJoinTable:
+		return t.broadcastTableState()
+	case MsgSit:
+		return t.handleSit(client, msg.Payload)
+	case MsgPostBlind:
+		return t.handlePostBlind(client)
+	case MsgFold:
+		return t.handleAction(client, actionFold, 0)
+	case MsgCheck:
+		return t.handleAction(client, actionCheck, 0)
+	case MsgCall:
+		return t.handleAction(client, actionCall, 0)
+	case MsgBet:
+		return t.handleBetOrRaise(client, actionBet, msg.Payload)
+	case MsgRaise:
+		return t.handleBetOrRaise(client, actionRaise, msg.Payload)
+	case MsgAllIn:
+		return t.handleAction(client, actionAllIn, 0)
+	case MsgLeave:
+		return t.handleLeave(client)
+	default:
+		return fmt.Errorf("unknown message type: %s", msg.Type)
+	}
+}
+
+/**
+ * StartHand shuffles a fresh deck, deals two hole cards to every seated
+ * player, and opens the action for the preflop betting round.
+ */
+func (t *HoldemTable) StartHand() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.startHand()
+}
+
+func (t *HoldemTable) startHand() error {
+	active := t.occupiedSeats()
+	if len(active) < 2 {
+		return fmt.Errorf("need at least 2 seated players to start a hand")
+	}
+
+	t.Deck = deck.NewDeck()
+	t.Deck.Shuffle()
+	t.CommunityCards = t.CommunityCards[:0]
+	t.Street = StreetPreflop
+	t.CurrentBet = 0
+	t.MinRaise = t.BigBlind
+
+	// Reset every seat's Contributed, not just the occupied ones - a seat
+	// a player left mid-hand keeps its Contributed around so endHand can
+	// still pay out chips it already put in the pot (see handleLeave),
+	// and that stale balance must not leak into the next hand if the
+	// seat stays empty or gets a new occupant.
+	for _, seat := range t.Seats {
+		seat.Bet = 0
+		seat.Contributed = 0
+	}
+
+	for _, i := range active {
+		t.Seats[i].HoleCards = nil
+		t.Seats[i].Status = SeatActive
+	}
+
+	for round := 0; round < 2; round++ {
+		for _, i := range active {
+			c, err := t.Deck.Draw()
+			if err != nil {
+				return fmt.Errorf("error dealing hole cards: %v", err)
+			}
+			t.Seats[i].HoleCards = append(t.Seats[i].HoleCards, c)
+		}
+	}
+
+	for _, i := range active {
+		if err := t.sendHoleCards(i); err != nil {
+			return err
+		}
+	}
+
+	// Heads-up is the exception to button-is-last-to-act-preflop: with
+	// only two players the button is the small blind and acts first
+	// preflop (and last on every later street), rather than skipping
+	// past to a separate small blind seat.
+	var sb, bb int
+	if len(active) == 2 {
+		sb = t.ButtonSeat
+		bb = t.nextOccupiedSeat(t.ButtonSeat, active)
+	} else {
+		sb = t.nextOccupiedSeat(t.ButtonSeat, active)
+		bb = t.nextOccupiedSeat(sb, active)
+	}
+	t.postBlind(sb, t.SmallBlind)
+	t.postBlind(bb, t.BigBlind)
+	t.CurrentBet = t.BigBlind
+
+	if len(active) == 2 {
+		t.ActionOn = sb
+	} else {
+		t.ActionOn = t.nextOccupiedSeat(bb, active)
+	}
+	t.toAct = len(t.activeSeats())
+
+	if err := t.broadcastTableState(); err != nil {
+		return err
+	}
+	return t.promptAction()
+}
+
+func (t *HoldemTable) postBlind(seatIdx, amount int) {
+	seat := t.Seats[seatIdx]
+	posted := amount
+	if posted >= seat.Stack {
+		posted = seat.Stack
+		seat.Status = SeatAllIn
+	}
+	seat.Stack -= posted
+	seat.Bet += posted
+	seat.Contributed += posted
+}
+
+func (t *HoldemTable) handlePostBlind(client *websocket.Client) error {
+	// Blinds are posted automatically by startHand; this message exists
+	// so a client can acknowledge it has seen the prompt and is ready.
+	_, err := t.seatIndexFor(client)
+	return err
+}
+
+/**
+ * dealFlop burns one card and deals three community cards.
+ */
+func (t *HoldemTable) dealFlop() error {
+	if _, err := t.Deck.Draw(); err != nil {
+		return fmt.Errorf("error burning card: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		c, err := t.Deck.Draw()
+		if err != nil {
+			return fmt.Errorf("error dealing flop: %v", err)
+		}
+		t.CommunityCards = append(t.CommunityCards, c)
+	}
+	t.Street = StreetFlop
+	return nil
+}
+
+func (t *HoldemTable) dealTurnOrRiver(street Street) error {
+	if _, err := t.Deck.Draw(); err != nil {
+		return fmt.Errorf("error burning card: %v", err)
+	}
+	c, err := t.Deck.Draw()
+	if err != nil {
+		return fmt.Errorf("error dealing %s: %v", street, err)
+	}
+	t.CommunityCards = append(t.CommunityCards, c)
+	t.Street = street
+	return nil
+}
+
+func (t *HoldemTable) handleSit(client *websocket.Client, payload interface{}) error {
+	var sit SitPayload
+	if err := decodePayload(payload, &sit); err != nil {
+		return fmt.Errorf("invalid sit payload: %v", err)
+	}
+	if sit.Seat < 0 || sit.Seat >= len(t.Seats) {
+		return fmt.Errorf("invalid seat index %d", sit.Seat)
+	}
+
+	seat := t.Seats[sit.Seat]
+	if seat.Status != SeatEmpty {
+		return fmt.Errorf("seat %d is already occupied", sit.Seat)
+	}
+	if sit.BuyIn <= 0 {
+		return fmt.Errorf("buy-in must be positive")
+	}
+
+	seat.Client = client
+	seat.Stack = sit.BuyIn
+	seat.Status = SeatActive
+
+	return t.broadcastTableState()
+}
+
+func (t *HoldemTable) handleLeave(client *websocket.Client) error {
+	idx, err := t.seatIndexFor(client)
+	if err != nil {
+		return err
+	}
+
+	if t.Street != StreetShowdown && t.Seats[idx].Status == SeatActive {
+		if idx == t.ActionOn {
+			if err := t.handleActionLocked(idx, actionFold, 0); err != nil {
+				return err
+			}
+		} else if err := t.forceFoldLocked(idx); err != nil {
+			return err
+		}
+	}
+
+	// Chips already committed to the pot don't leave with the player -
+	// endHand and broadcastTableState both total the pot from
+	// Contributed, so replacing the seat wholesale would silently drop
+	// the leaver's stake from the payout. Keep Contributed and only
+	// clear what's needed to free the seat back up.
+	t.Seats[idx].Client = nil
+	t.Seats[idx].Stack = 0
+	t.Seats[idx].HoleCards = nil
+	t.Seats[idx].Status = SeatEmpty
+	return t.broadcastTableState()
+}
+
+// forceFoldLocked folds a seat that is not currently ActionOn. A
+// disconnect or leave can arrive for any seat at any time, not just the
+// one whose turn it is, so - unlike handleActionLocked - this bypasses
+// the turn-order gate rather than rejecting the fold.
+func (t *HoldemTable) forceFoldLocked(idx int) error {
+	t.Seats[idx].Status = SeatFolded
+	t.toAct--
+
+	if len(t.contestedSeats()) <= 1 {
+		return t.endHand()
+	}
+	if t.toAct <= 0 {
+		return t.advanceStreet()
+	}
+	return nil
+}
+
+func (t *HoldemTable) handleBetOrRaise(client *websocket.Client, a action, payload interface{}) error {
+	var bet BetPayload
+	if err := decodePayload(payload, &bet); err != nil {
+		return fmt.Errorf("invalid bet payload: %v", err)
+	}
+
+	idx, err := t.seatIndexFor(client)
+	if err != nil {
+		return err
+	}
+	return t.handleActionLocked(idx, a, bet.Amount)
+}
+
+func (t *HoldemTable) handleAction(client *websocket.Client, a action, amount int) error {
+	idx, err := t.seatIndexFor(client)
+	if err != nil {
+		return err
+	}
+	return t.handleActionLocked(idx, a, amount)
+}
+
+func (t *HoldemTable) handleActionLocked(idx int, a action, amount int) error {
+	if idx != t.ActionOn {
+		return fmt.Errorf("it is not seat %d's turn to act", idx)
+	}
+
+	seat := t.Seats[idx]
+	toCall := t.CurrentBet - seat.Bet
+
+	switch a {
+	case actionFold:
+		seat.Status = SeatFolded
+
+	case actionCheck:
+		if toCall != 0 {
+			return fmt.Errorf("cannot check, %d is owed to call", toCall)
+		}
+
+	case actionCall:
+		call := toCall
+		if call >= seat.Stack {
+			call = seat.Stack
+			seat.Status = SeatAllIn
+		}
+		seat.Stack -= call
+		seat.Bet += call
+		seat.Contributed += call
+
+	case actionBet, actionRaise:
+		if amount < t.CurrentBet+t.MinRaise && amount < seat.Bet+seat.Stack {
+			return fmt.Errorf("raise to %d is below the minimum raise of %d", amount, t.CurrentBet+t.MinRaise)
+		}
+		raiseBy := amount - t.CurrentBet
+		delta := amount - seat.Bet
+		if delta >= seat.Stack {
+			delta = seat.Stack
+			seat.Status = SeatAllIn
+		}
+		seat.Stack -= delta
+		seat.Bet += delta
+		seat.Contributed += delta
+		t.CurrentBet = seat.Bet
+		// An all-in for less than a full raise doesn't reopen betting for
+		// seats that've already called the prior bet - only a raise that
+		// meets or exceeds MinRaise does.
+		reopensAction := raiseBy >= t.MinRaise
+		if raiseBy > t.MinRaise {
+			t.MinRaise = raiseBy
+		}
+		if reopensAction {
+			t.toAct = t.otherActiveSeats(idx) + 1 // raise reopens action for everyone else; +1 offsets the t.toAct-- below for this action
+		}
+
+	case actionAllIn:
+		delta := seat.Stack
+		seat.Stack = 0
+		seat.Bet += delta
+		seat.Contributed += delta
+		seat.Status = SeatAllIn
+		if seat.Bet > t.CurrentBet {
+			raiseBy := seat.Bet - t.CurrentBet
+			t.CurrentBet = seat.Bet
+			reopensAction := raiseBy >= t.MinRaise
+			if raiseBy > t.MinRaise {
+				t.MinRaise = raiseBy
+			}
+			if reopensAction {
+				t.toAct = t.otherActiveSeats(idx) + 1
+			}
+		}
+
+	default:
+		return fmt.Errorf("unsupported action %q", a)
+	}
+
+	t.toAct--
+
+	if len(t.contestedSeats()) <= 1 {
+		return t.endHand()
+	}
+	if t.toAct <= 0 {
+		return t.advanceStreet()
+	}
+
+	t.ActionOn = t.nextOccupiedSeat(t.ActionOn, t.activeSeats())
+	if err := t.broadcastTableState(); err != nil {
+		return err
+	}
+	return t.promptAction()
+}
+
+func (t *HoldemTable) advanceStreet() error {
+	for _, seat := range t.Seats {
+		seat.Bet = 0
+	}
+	t.CurrentBet = 0
+	t.MinRaise = t.BigBlind
+
+	var err error
+	switch t.Street {
+	case StreetPreflop:
+		err = t.dealFlop()
+	case StreetFlop:
+		err = t.dealTurnOrRiver(StreetTurn)
+	case StreetTurn:
+		err = t.dealTurnOrRiver(StreetRiver)
+	default:
+		return t.endHand()
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := t.broadcastBoard(); err != nil {
+		return err
+	}
+
+	contested := t.contestedSeats()
+	if len(contested) <= 1 {
+		return t.endHand()
+	}
+
+	// If fewer than two seats can still act, everyone left is all-in -
+	// there's no decision to prompt for, so run the board out to
+	// showdown instead of stalling on a seat with no legal action.
+	if active := t.activeSeats(); len(active) < 2 {
+		return t.advanceStreet()
+	}
+
+	t.ActionOn = t.nextOccupiedSeat(t.ButtonSeat, t.activeSeats())
+	t.toAct = len(t.activeSeats())
+	if err := t.broadcastTableState(); err != nil {
+		return err
+	}
+	return t.promptAction()
+}
+
+func (t *HoldemTable) endHand() error {
+	t.Street = StreetShowdown
+
+	// With everyone else folded, the last contested seat wins the pot
+	// uncontested - there is nothing to evaluate, and the community cards
+	// may not even be out yet (e.g. everyone folds to a preflop raise).
+	if contested := t.contestedSeats(); len(contested) <= 1 {
+		return t.endHandUncontested(contested)
+	}
+
+	contributions := make(map[int]int)
+	folded := make(map[int]bool)
+	results := make(map[int]pokerhand.HandResult)
+
+	for i, seat := range t.Seats {
+		contributions[i] = seat.Contributed
+		// A seat that's Empty here left mid-hand (see handleLeave) and
+		// still has chips in the pot to be accounted for - treat it like
+		// a fold: eligible to have contributed, not eligible to win.
+		folded[i] = seat.Status != SeatActive && seat.Status != SeatAllIn
+
+		if !folded[i] {
+			result, err := t.Evaluator.Evaluate(seat.HoleCards, t.CommunityCards)
+			if err != nil {
+				return fmt.Errorf("error evaluating seat %d: %v", i, err)
+			}
+			results[i] = result
+		}
+	}
+
+	pots := calculateSidePots(contributions, folded)
+	payouts := make(map[int]int)
+	winningSeats := map[int]bool{}
+
+	for _, pot := range pots {
+		winners := bestSeats(pot.EligibleSeats, results)
+		share := pot.Amount / len(winners)
+		remainder := pot.Amount % len(winners)
+		for i, seat := range winners {
+			amount := share
+			if i < remainder {
+				amount++
+			}
+			payouts[seat] += amount
+			winningSeats[seat] = true
+		}
+	}
+
+	for seat, amount := range payouts {
+		t.Seats[seat].Stack += amount
+	}
+
+	if err := t.broadcastShowdown(results, payouts, winningSeats); err != nil {
+		return err
+	}
+
+	return t.broadcastHandEnded(winningSeats)
+}
+
+// endHandUncontested pays the whole pot to the sole remaining seat, if
+// any, without evaluating hands - nobody needs to show down a hand when
+// everyone else has folded.
+func (t *HoldemTable) endHandUncontested(contested []int) error {
+	payouts := make(map[int]int)
+	winningSeats := map[int]bool{}
+
+	if len(contested) == 1 {
+		winner := contested[0]
+		amount := 0
+		for _, seat := range t.Seats {
+			amount += seat.Contributed
+		}
+		t.Seats[winner].Stack += amount
+		payouts[winner] = amount
+		winningSeats[winner] = true
+	}
+
+	if err := t.broadcastShowdown(map[int]pokerhand.HandResult{}, payouts, winningSeats); err != nil {
+		return err
+	}
+	return t.broadcastHandEnded(winningSeats)
+}
+
+func (t *HoldemTable) broadcastHandEnded(winningSeats map[int]bool) error {
+	winners := make([]int, 0, len(winningSeats))
+	for seat := range winningSeats {
+		winners = append(winners, seat)
+	}
+	return t.Hub.Broadcast(websocket.Message{Type: EventHandEnded, Payload: HandEndedPayload{WinningSeats: winners}})
+}
+
+func bestSeats(eligible []int, results map[int]pokerhand.HandResult) []int {
+	var best []int
+	var bestResult pokerhand.HandResult
+	haveBest := false
+
+	for _, seat := range eligible {
+		result, ok := results[seat]
+		if !ok {
+			continue // folded before showdown, not eligible to win
+		}
+		if !haveBest {
+			best = []int{seat}
+			bestResult = result
+			haveBest = true
+			continue
+		}
+		cmp := pokerhand.CompareHands(result, bestResult)
+		if cmp > 0 {
+			best = []int{seat}
+			bestResult = result
+		} else if cmp == 0 {
+			best = append(best, seat)
+		}
+	}
+	return best
+}
+
+func (t *HoldemTable) promptAction() error {
+	seat := t.Seats[t.ActionOn]
+	toCall := t.CurrentBet - seat.Bet
+
+	legal := []string{string(actionFold)}
+	if toCall == 0 {
+		legal = append(legal, string(actionCheck))
+	} else {
+		legal = append(legal, string(actionCall))
+	}
+	if seat.Stack > 0 {
+		legal = append(legal, string(actionBet), string(actionRaise), string(actionAllIn))
+	}
+
+	payload := ActionPromptPayload{
+		Seat:          t.ActionOn,
+		LegalActions:  legal,
+		CurrentBet:    t.CurrentBet,
+		MinRaiseTo:    t.CurrentBet + t.MinRaise,
+		TimeoutMillis: t.ActionTimeout.Milliseconds(),
+	}
+	return t.Hub.Broadcast(websocket.Message{Type: EventActionPrompt, Payload: payload})
+}
+
+func (t *HoldemTable) sendHoleCards(seatIdx int) error {
+	seat := t.Seats[seatIdx]
+	if seat.Client == nil {
+		return nil
+	}
+
+	payload := HoleCardsPayload{Seat: seatIdx, HoleCards: shortStrings(seat.HoleCards)}
+	return t.Hub.SendTo(seat.Client, websocket.Message{Type: EventHoleCards, Payload: payload})
+}
+
+func (t *HoldemTable) broadcastBoard() error {
+	return t.Hub.Broadcast(websocket.Message{Type: EventBoard, Payload: shortStrings(t.CommunityCards)})
+}
+
+func (t *HoldemTable) broadcastShowdown(results map[int]pokerhand.HandResult, payouts map[int]int, winners map[int]bool) error {
+	payload := ShowdownPayload{}
+	for i, seat := range t.Seats {
+		result, ok := results[i]
+		if !ok {
+			continue
+		}
+		payload.Results = append(payload.Results, SeatShowdown{
+			Seat:      i,
+			HoleCards: shortStrings(seat.HoleCards),
+			HandName:  result.Name,
+			IsWinner:  winners[i],
+			Payout:    payouts[i],
+		})
+	}
+	return t.Hub.Broadcast(websocket.Message{Type: EventShowdown, Payload: payload})
+}
+
+func (t *HoldemTable) broadcastTableState() error {
+	pot := 0
+	summaries := make([]SeatSummary, len(t.Seats))
+	for i, seat := range t.Seats {
+		pot += seat.Contributed
+		summaries[i] = SeatSummary{
+			Seat:     i,
+			Occupied: seat.Status != SeatEmpty,
+			Stack:    seat.Stack,
+			Bet:      seat.Bet,
+			Status:   string(seat.Status),
+		}
+	}
+
+	payload := TableStatePayload{
+		TableID:        t.ID,
+		Street:         string(t.Street),
+		Pot:            pot,
+		ButtonSeat:     t.ButtonSeat,
+		ActionOn:       t.ActionOn,
+		CurrentBet:     t.CurrentBet,
+		CommunityCards: shortStrings(t.CommunityCards),
+		Seats:          summaries,
+	}
+	return t.Hub.Broadcast(websocket.Message{Type: EventTableState, Payload: payload})
+}
+
+func (t *HoldemTable) seatIndexFor(client *websocket.Client) (int, error) {
+	for i, seat := range t.Seats {
+		if seat.Client == client {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("client is not seated at this table")
+}
+
+func (t *HoldemTable) occupiedSeats() []int {
+	var seats []int
+	for i, seat := range t.Seats {
+		if seat.Status != SeatEmpty {
+			seats = append(seats, i)
+		}
+	}
+	return seats
+}
+
+// otherActiveSeats counts seats who can still act this street, not
+// counting idx. Used when a bet/raise/all-in reopens action: idx may
+// still be SeatActive (so activeSeats would double-count it) or may have
+// just gone SeatAllIn (so activeSeats would already exclude it) -
+// counting by index instead of re-deriving from Status keeps both cases
+// landing on the same number, regardless of whether the actor is still
+// counted as active.
+func (t *HoldemTable) otherActiveSeats(idx int) int {
+	count := 0
+	for i, seat := range t.Seats {
+		if i != idx && seat.Status == SeatActive {
+			count++
+		}
+	}
+	return count
+}
+
+// contestedSeats are seats still able to win the hand: not folded, and
+// not empty. Use this for "is the hand still live"/showdown-eligibility
+// checks, not for picking who acts next - an all-in seat is contested
+// but has no decision left to make.
+func (t *HoldemTable) contestedSeats() []int {
+	var seats []int
+	for i, seat := range t.Seats {
+		if seat.Status == SeatActive || seat.Status == SeatAllIn {
+			seats = append(seats, i)
+		}
+	}
+	return seats
+}
+
+// activeSeats are seats that can still act this street. Unlike
+// contestedSeats, this excludes all-in seats - they're still in the
+// hand for showdown purposes, but promptAction has nothing legal left
+// to ask them for.
+func (t *HoldemTable) activeSeats() []int {
+	var seats []int
+	for i, seat := range t.Seats {
+		if seat.Status == SeatActive {
+			seats = append(seats, i)
+		}
+	}
+	return seats
+}
+
+func (t *HoldemTable) nextOccupiedSeat(from int, within []int) int {
+	inSet := make(map[int]bool, len(within))
+	for _, s := range within {
+		inSet[s] = true
+	}
+
+	for i := 1; i <= len(t.Seats); i++ {
+		idx := (from + i) % len(t.Seats)
+		if inSet[idx] {
+			return idx
+		}
+	}
+	return from
+}
+
+func shortStrings(cards []card.Card) []string {
+	out := make([]string, len(cards))
+	for i, c := range cards {
+		out[i] = c.Short()
+	}
+	return out
+}
+
+func decodePayload(payload interface{}, out interface{}) error {
+	buf, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, out)
+}
@@ -0,0 +1,179 @@
+package gameserver
+
+import (
+	"testing"
+
+	"github.com/blixxurd/card-game-go/internal/net"
+)
+
+// TestHandleActionAllInRaiseDoesNotSkipRemainingActors pins a bug where an
+// all-in bet/raise closed the betting round one seat early:
+// handleActionLocked computed toAct from activeSeats(), which already
+// excludes the raiser once their Status flips to SeatAllIn, and then
+// unconditionally decremented toAct again for the raiser's own action.
+func TestHandleActionAllInRaiseDoesNotSkipRemainingActors(t *testing.T) {
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	table := NewHoldemTable("table-1", hub, 4, 10, 20)
+	for i := range table.Seats {
+		table.Seats[i].Status = SeatActive
+		table.Seats[i].Stack = 1000
+	}
+
+	if err := table.startHand(); err != nil {
+		t.Fatalf("startHand: %v", err)
+	}
+
+	utg := table.ActionOn
+	table.Seats[utg].Stack = 100 // short stack: the raise below exactly covers it
+
+	if err := table.handleActionLocked(utg, actionRaise, 100); err != nil {
+		t.Fatalf("handleActionLocked(all-in raise): %v", err)
+	}
+
+	if table.Seats[utg].Status != SeatAllIn {
+		t.Fatalf("expected raiser to be all-in, got status %q", table.Seats[utg].Status)
+	}
+	if got := table.toAct; got != 3 {
+		t.Fatalf("expected 3 seats still owed a decision after the all-in raise, got %d", got)
+	}
+
+	for i := 0; i < 3; i++ {
+		actor := table.ActionOn
+		if table.Street != StreetPreflop {
+			t.Fatalf("street advanced after only %d of 3 remaining seats acted", i)
+		}
+		if err := table.handleActionLocked(actor, actionCall, 0); err != nil {
+			t.Fatalf("handleActionLocked(call) for seat %d: %v", actor, err)
+		}
+	}
+
+	if table.Street != StreetFlop {
+		t.Fatalf("expected street to advance to flop once all 3 remaining seats acted, got %q", table.Street)
+	}
+}
+
+// TestHandleActionSkipsAllInSeatForNextToAct pins a bug where ActionOn was
+// advanced over contestedSeats(), which includes all-in seats - after a
+// seat acted, ActionOn could land back on a seat that was already
+// SeatAllIn and had no legal decision left to make.
+func TestHandleActionSkipsAllInSeatForNextToAct(t *testing.T) {
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	table := NewHoldemTable("table-1", hub, 4, 10, 20)
+	for i := range table.Seats {
+		table.Seats[i].Status = SeatActive
+		table.Seats[i].Stack = 1000
+	}
+
+	if err := table.startHand(); err != nil {
+		t.Fatalf("startHand: %v", err)
+	}
+
+	table.ActionOn = 0
+	table.Seats[1].Status = SeatAllIn
+
+	if err := table.handleActionLocked(0, actionCall, 0); err != nil {
+		t.Fatalf("handleActionLocked(call) for seat 0: %v", err)
+	}
+
+	if table.ActionOn != 2 {
+		t.Fatalf("expected ActionOn to skip all-in seat 1 and land on seat 2, got %d", table.ActionOn)
+	}
+}
+
+// TestAdvanceStreetCountsOnlyActiveSeats pins a bug where advanceStreet
+// seeded toAct from contestedSeats(), which includes all-in seats who
+// never act again. With one seat all-in and two genuinely active seats,
+// toAct was set one too high and never reached 0 from real
+// handleActionLocked calls, so the betting round never closed once it
+// was dealt.
+func TestAdvanceStreetCountsOnlyActiveSeats(t *testing.T) {
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	table := NewHoldemTable("table-1", hub, 3, 10, 20)
+	for i := range table.Seats {
+		table.Seats[i].Status = SeatActive
+		table.Seats[i].Stack = 1000
+	}
+
+	if err := table.startHand(); err != nil {
+		t.Fatalf("startHand: %v", err)
+	}
+
+	shover := table.ActionOn
+	table.Seats[shover].Stack = 30 // short stack: the raise below exactly covers it
+
+	if err := table.handleActionLocked(shover, actionRaise, 30); err != nil {
+		t.Fatalf("handleActionLocked(all-in raise): %v", err)
+	}
+	if table.Seats[shover].Status != SeatAllIn {
+		t.Fatalf("expected shover to be all-in, got status %q", table.Seats[shover].Status)
+	}
+
+	for i := 0; i < 2; i++ {
+		actor := table.ActionOn
+		if table.Street != StreetPreflop {
+			t.Fatalf("street advanced after only %d of 2 remaining seats called", i)
+		}
+		if err := table.handleActionLocked(actor, actionCall, 0); err != nil {
+			t.Fatalf("handleActionLocked(call) for seat %d: %v", actor, err)
+		}
+	}
+
+	if table.Street != StreetFlop {
+		t.Fatalf("expected street to advance to flop once both remaining seats called, got %q", table.Street)
+	}
+
+	for i := 0; i < 2; i++ {
+		actor := table.ActionOn
+		if table.Street != StreetFlop {
+			t.Fatalf("street advanced after only %d of 2 active seats checked", i)
+		}
+		if err := table.handleActionLocked(actor, actionCheck, 0); err != nil {
+			t.Fatalf("handleActionLocked(check) for seat %d: %v", actor, err)
+		}
+	}
+
+	if table.Street != StreetTurn {
+		t.Fatalf("expected street to advance to turn once both active seats checked the flop, got %q (toAct=%d)", table.Street, table.toAct)
+	}
+}
+
+// TestHandleLeaveFoldsSeatOutOfTurn pins a bug where handleLeave routed
+// every mid-hand leave through handleActionLocked(idx, actionFold, 0),
+// which rejects with "it is not seat %d's turn to act" whenever idx isn't
+// ActionOn - leaving the leaving seat stuck as SeatActive.
+//
+// This sets up mid-hand state directly rather than going through
+// startHand: startHand delivers hole cards to each seated Client over
+// the Hub, which requires the Client to have gone through the Hub's
+// real registration path - more than this test needs to exercise
+// handleLeave/forceFoldLocked.
+func TestHandleLeaveFoldsSeatOutOfTurn(t *testing.T) {
+	hub := websocket.NewHub()
+	go hub.Run()
+
+	table := NewHoldemTable("table-1", hub, 4, 10, 20)
+	clients := make([]*websocket.Client, len(table.Seats))
+	for i := range table.Seats {
+		clients[i] = &websocket.Client{Hub: hub}
+		table.Seats[i].Client = clients[i]
+		table.Seats[i].Status = SeatActive
+		table.Seats[i].Stack = 1000
+	}
+	table.ActionOn = 0
+	table.toAct = len(table.Seats)
+
+	leaver := 2
+	if err := table.handleLeave(clients[leaver]); err != nil {
+		t.Fatalf("handleLeave for seat %d not on turn: %v", leaver, err)
+	}
+
+	if table.Seats[leaver].Status != SeatEmpty {
+		t.Fatalf("expected seat %d to be cleared after leaving, got status %q", leaver, table.Seats[leaver].Status)
+	}
+}
@@ -0,0 +1,119 @@
+package gameserver
+
+// MARK: Types
+
+/**
+ * Inbound message types a client may send over the websocket Hub to
+ * interact with a HoldemTable.
+ */
+const (
+	MsgJoinTable = "join_table"
+	MsgSit       = "sit"
+	MsgPostBlind = "post_blind"
+	MsgFold      = "fold"
+	MsgCheck     = "check"
+	MsgCall      = "call"
+	MsgBet       = "bet"
+	MsgRaise     = "raise"
+	MsgAllIn     = "all_in"
+	MsgLeave     = "leave"
+)
+
+/**
+ * Outbound message types the server emits to clients of a HoldemTable.
+ */
+const (
+	EventTableState   = "table_state"
+	EventHoleCards    = "hole_cards"
+	EventBoard        = "board"
+	EventActionPrompt = "action_prompt"
+	EventShowdown     = "showdown"
+	EventHandEnded    = "hand_ended"
+	EventError        = "error"
+)
+
+/**
+ * SitPayload is the payload of a "sit" inbound message: the seat a player
+ * wants to take and the stack they're buying in for.
+ */
+type SitPayload struct {
+	Seat  int `json:"seat"`
+	BuyIn int `json:"buy_in"`
+}
+
+/**
+ * BetPayload is the payload of a "bet" or "raise" inbound message.
+ */
+type BetPayload struct {
+	Amount int `json:"amount"`
+}
+
+/**
+ * TableStatePayload is the payload of the "table_state" outbound event: a
+ * public snapshot of the table that carries no hidden information.
+ */
+type TableStatePayload struct {
+	TableID        string        `json:"table_id"`
+	Street         string        `json:"street"`
+	Pot            int           `json:"pot"`
+	ButtonSeat     int           `json:"button_seat"`
+	ActionOn       int           `json:"action_on"`
+	CurrentBet     int           `json:"current_bet"`
+	CommunityCards []string      `json:"community_cards"`
+	Seats          []SeatSummary `json:"seats"`
+}
+
+/**
+ * SeatSummary is the public view of a single seat, omitting hole cards.
+ */
+type SeatSummary struct {
+	Seat     int    `json:"seat"`
+	Occupied bool   `json:"occupied"`
+	Stack    int    `json:"stack"`
+	Bet      int    `json:"bet"`
+	Status   string `json:"status"`
+}
+
+/**
+ * HoleCardsPayload is the payload of the "hole_cards" outbound event,
+ * sent only to the seated player via Hub.SendTo.
+ */
+type HoleCardsPayload struct {
+	Seat      int      `json:"seat"`
+	HoleCards []string `json:"hole_cards"`
+}
+
+/**
+ * ActionPromptPayload is the payload of the "action_prompt" outbound
+ * event, telling the acting player which actions are currently legal.
+ */
+type ActionPromptPayload struct {
+	Seat          int      `json:"seat"`
+	LegalActions  []string `json:"legal_actions"`
+	CurrentBet    int      `json:"current_bet"`
+	MinRaiseTo    int      `json:"min_raise_to"`
+	TimeoutMillis int64    `json:"timeout_millis"`
+}
+
+/**
+ * ShowdownPayload is the payload of the "showdown" outbound event: each
+ * seat still in the hand reveals its cards and resulting HandResult.
+ */
+type ShowdownPayload struct {
+	Results []SeatShowdown `json:"results"`
+}
+
+type SeatShowdown struct {
+	Seat      int      `json:"seat"`
+	HoleCards []string `json:"hole_cards"`
+	HandName  string   `json:"hand_name"`
+	IsWinner  bool     `json:"is_winner"`
+	Payout    int      `json:"payout"`
+}
+
+/**
+ * HandEndedPayload is the payload of the "hand_ended" outbound event.
+ */
+type HandEndedPayload struct {
+	WinningSeats []int `json:"winning_seats"`
+}
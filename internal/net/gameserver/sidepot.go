@@ -0,0 +1,40 @@
+package gameserver
+
+import "github.com/blixxurd/card-game-go/internal/games/poker/pot"
+
+// MARK: Types
+
+/**
+ * SidePot is a pot eligible to be won only by the seats listed in
+ * EligibleSeats - the mechanism that lets an all-in player compete for
+ * the chips they covered while later, bigger bets form a separate pot
+ * among the remaining players.
+ */
+type SidePot struct {
+	Amount        int
+	EligibleSeats []int
+}
+
+// MARK: Functions
+
+/**
+ * calculateSidePots splits total contributions into one or more pots so
+ * that a player who went all-in for less than another player's bet can
+ * only win up to the amount they contributed, with everything above that
+ * forming side pots among the players still able to match it. It's a
+ * seat-indexed wrapper around pot.CalculateSidePots, which implements the
+ * algorithm shared with holdem.Pot's player-indexed side pots.
+ *
+ * contributions maps seat index to the total chips that seat put in this
+ * hand. foldedSeats marks seats that contributed but are no longer
+ * eligible to win any pot.
+ */
+func calculateSidePots(contributions map[int]int, foldedSeats map[int]bool) []SidePot {
+	shared := pot.CalculateSidePots(contributions, foldedSeats)
+
+	pots := make([]SidePot, len(shared))
+	for i, sp := range shared {
+		pots[i] = SidePot{Amount: sp.Amount, EligibleSeats: sp.EligiblePlayers}
+	}
+	return pots
+}
@@ -26,6 +26,18 @@ type Hub struct {
 	register   chan *Client
 	unregister chan *Client
 	mutex      sync.Mutex
+
+	table TableHandler
+}
+
+/**
+ * TableHandler receives every inbound Message a Client's ReadPump reads
+ * off the wire, so a game (e.g. gameserver.HoldemTable) can be plugged
+ * into the transport without this package importing it. A Hub with no
+ * TableHandler attached just logs unrecognized message types.
+ */
+type TableHandler interface {
+	HandleMessage(client *Client, msg Message) error
 }
 
 // MARK: Functions
@@ -44,6 +56,25 @@ func NewHub() *Hub {
 	}
 }
 
+// MARK: Functions
+
+/**
+ * NewClient wraps an already-upgraded WebSocket connection as a Client,
+ * registers it with hub, and starts its read/write pumps. Callers'
+ * HTTP upgrade handler should use this instead of constructing a Client
+ * directly - a Client that was never registered here will make every
+ * Hub.SendTo to it fail with "client is not registered with this hub".
+ */
+func NewClient(hub *Hub, conn *websocket.Conn) *Client {
+	client := &Client{Hub: hub, Conn: conn, Send: make(chan []byte, 256)}
+	hub.register <- client
+
+	go client.WritePump()
+	go client.ReadPump()
+
+	return client
+}
+
 // MARK: Methods
 
 /**
@@ -93,6 +124,42 @@ func (h *Hub) Broadcast(message Message) error {
 	return nil
 }
 
+/**
+ * SetTable attaches the TableHandler that ReadPump dispatches every
+ * inbound message to. Must be called before any Client starts reading -
+ * typically right after NewHub, before the first NewClient.
+ */
+func (h *Hub) SetTable(t TableHandler) {
+	h.table = t
+}
+
+/**
+ * SendTo sends a message to a single client, marshaled to JSON. Unlike
+ * Broadcast, this lets callers keep information private to one client -
+ * e.g. a player's own hole cards - instead of fanning it out to everyone
+ * registered on the Hub.
+ */
+func (h *Hub) SendTo(client *Client, message Message) error {
+	json, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %v", err)
+	}
+
+	h.mutex.Lock()
+	_, ok := h.clients[client]
+	h.mutex.Unlock()
+	if !ok {
+		return fmt.Errorf("client is not registered with this hub")
+	}
+
+	select {
+	case client.Send <- json:
+	default:
+		return fmt.Errorf("client send buffer is full")
+	}
+	return nil
+}
+
 /**
  * ReadPump handles reading messages from a client's WebSocket connection.
  * It continuously pulls data from the client into the Hub.
@@ -118,7 +185,15 @@ func (c *Client) ReadPump() {
 			continue
 		}
 
-		// Handle the message based on its type
+		if c.Hub.table != nil {
+			if err := c.Hub.table.HandleMessage(c, msg); err != nil {
+				fmt.Printf("error handling message %s: %v", msg.Type, err)
+			}
+			continue
+		}
+
+		// No TableHandler attached - fall back to the original placeholder
+		// routing so a Hub without a game wired in still compiles/runs.
 		switch msg.Type {
 		case "join_game":
 			// Handle join game logic